@@ -45,6 +45,24 @@ var (
 	CivoKubernetesGroupVersionKind = SchemeGroupVersion.WithKind(CivoKubernetesKind)
 )
 
+// CivoKubernetesNodePool type metadata.
+var (
+	CivoKubernetesNodePoolKind             = reflect.TypeOf(CivoKubernetesNodePool{}).Name()
+	CivoKubernetesNodePoolGroupKind        = schema.GroupKind{Group: Group, Kind: CivoKubernetesNodePoolKind}.String()
+	CivoKubernetesNodePoolKindAPIVersion   = CivoKubernetesNodePoolKind + "." + SchemeGroupVersion.String()
+	CivoKubernetesNodePoolGroupVersionKind = SchemeGroupVersion.WithKind(CivoKubernetesNodePoolKind)
+)
+
+// CivoKubernetesApplication type metadata.
+var (
+	CivoKubernetesApplicationKind             = reflect.TypeOf(CivoKubernetesApplication{}).Name()
+	CivoKubernetesApplicationGroupKind        = schema.GroupKind{Group: Group, Kind: CivoKubernetesApplicationKind}.String()
+	CivoKubernetesApplicationKindAPIVersion   = CivoKubernetesApplicationKind + "." + SchemeGroupVersion.String()
+	CivoKubernetesApplicationGroupVersionKind = SchemeGroupVersion.WithKind(CivoKubernetesApplicationKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CivoKubernetes{}, &CivoKubernetesList{})
+	SchemeBuilder.Register(&CivoKubernetesNodePool{}, &CivoKubernetesNodePoolList{})
+	SchemeBuilder.Register(&CivoKubernetesApplication{}, &CivoKubernetesApplicationList{})
 }
@@ -30,24 +30,165 @@ type CivoKubernetesParameters struct {
 
 // CivoKubernetesObservation are the observable fields of a CivoKubernetes.
 type CivoKubernetesObservation struct {
-	ObservableField string `json:"observableField,omitempty"`
+	// ID is the Civo ID of the cluster.
+	ID string `json:"id,omitempty"`
+	// Status of the cluster on Civo, e.g. ACTIVE.
+	Status string `json:"status,omitempty"`
+	// MasterIP is the public IP of the cluster's API server.
+	MasterIP string `json:"masterIp,omitempty"`
+	// APIEndpoint is the URL of the cluster's API server.
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+	// DNSEntry is the DNS name of the cluster's API server.
+	DNSEntry string `json:"dnsEntry,omitempty"`
+	// Version is the current Kubernetes version of the cluster.
+	Version string `json:"version,omitempty"`
+	// UpgradeAvailableTo is the version the cluster can be upgraded to, if any.
+	UpgradeAvailableTo string `json:"upgradeAvailableTo,omitempty"`
+	// FirewallID is the Civo ID of the firewall of the cluster.
+	FirewallID string `json:"firewallId,omitempty"`
+	// NetworkID is the Civo ID of the network of the cluster.
+	NetworkID string `json:"networkId,omitempty"`
+	// Tags of the cluster on Civo.
+	Tags []string `json:"tags,omitempty"`
+	// KubeconfigHash is the SHA-256 hash of the cluster's kubeconfig. It is
+	// used to detect when Civo rotates the kubeconfig.
+	KubeconfigHash string `json:"kubeconfigHash,omitempty"`
+	// InstalledApplications are the marketplace applications installed on the
+	// cluster.
+	InstalledApplications []KubernetesApplicationObservation `json:"installedApplications,omitempty"`
+	// ManagedApplications are the names of the installed applications that
+	// were listed in spec.applications. Only these are uninstalled when they
+	// are removed from the spec.
+	ManagedApplications []string `json:"managedApplications,omitempty"`
+	// Pools are the node pools of the cluster, including the ones managed by
+	// CivoKubernetesNodePool resources.
+	Pools []KubernetesPoolObservation `json:"pools,omitempty"`
+	// DeletingPools are the IDs of the pools of the cluster spec whose
+	// deletion was requested and that Civo has not removed yet.
+	DeletingPools []string `json:"deletingPools,omitempty"`
 }
 
+// KubernetesApplicationObservation is the observed state of a marketplace
+// application installed on a cluster.
+type KubernetesApplicationObservation struct {
+	// Name of the application.
+	Name string `json:"name"`
+	// Version of the application.
+	Version string `json:"version,omitempty"`
+	// Installed is true once the application has been installed.
+	Installed bool `json:"installed,omitempty"`
+}
+
+// Pool states reported in KubernetesPoolObservation.
+const (
+	// PoolStateReady means all nodes of the pool are active.
+	PoolStateReady = "Ready"
+	// PoolStateScaling means nodes of the pool are being created or removed.
+	PoolStateScaling = "Scaling"
+)
+
+// KubernetesPoolObservation is the observed state of a node pool of a cluster.
+type KubernetesPoolObservation struct {
+	// ID of the pool.
+	ID string `json:"id"`
+	// Size is the Civo size of the pool's nodes.
+	Size string `json:"size,omitempty"`
+	// Count is the desired number of nodes in the pool.
+	Count int `json:"count,omitempty"`
+	// ActiveNodes is the number of nodes of the pool that are active.
+	ActiveNodes int `json:"activeNodes,omitempty"`
+	// State of the pool, either Ready or Scaling.
+	State string `json:"state,omitempty"`
+	// Instances are the nodes of the pool.
+	Instances []KubernetesInstanceObservation `json:"instances,omitempty"`
+}
+
+// KubernetesInstanceObservation is the observed state of a node of a cluster.
+type KubernetesInstanceObservation struct {
+	// Name is the hostname of the node.
+	Name string `json:"name"`
+	// PublicIP of the node, if it has one.
+	PublicIP string `json:"publicIp,omitempty"`
+	// Status of the node on Civo, e.g. ACTIVE.
+	Status string `json:"status,omitempty"`
+}
+
+// Policies for applications removed from spec.applications.
+const (
+	// ApplicationRemovalPolicyKeep leaves removed applications installed.
+	ApplicationRemovalPolicyKeep = "Keep"
+	// ApplicationRemovalPolicyUninstall uninstalls removed applications.
+	ApplicationRemovalPolicyUninstall = "Uninstall"
+)
+
+// Policies for upgrading the Kubernetes version of a cluster.
+const (
+	// UpgradePolicyManual upgrades to any newer spec.version.
+	UpgradePolicyManual = "Manual"
+	// UpgradePolicyPatchOnly upgrades to a newer spec.version of the same minor version.
+	UpgradePolicyPatchOnly = "PatchOnly"
+	// UpgradePolicyMinor upgrades to a newer spec.version up to the next minor version.
+	UpgradePolicyMinor = "Minor"
+	// UpgradePolicyAutoLatestPatch upgrades to the latest patch release Civo offers.
+	UpgradePolicyAutoLatestPatch = "AutoLatestPatch"
+)
+
+// Keys of the provenance tags added to clusters.
+const (
+	// TagKeyName is the key of the tag holding the name of the managed resource.
+	TagKeyName = "crossplane-name"
+	// TagKeyUID is the key of the tag holding the UID of the managed resource.
+	TagKeyUID = "crossplane-uid"
+)
+
 // CivoKubernetesConnectionDetails is the desired output secret to store connection information
+//
+// Deprecated: Use writeConnectionSecretToRef or publishConnectionDetailsTo
+// instead. When neither is set, writeConnectionSecretToRef defaults to a
+// secret named <connectionSecretNamePrefix>-<metadata.name> in
+// connectionSecretNamespace.
 type CivoKubernetesConnectionDetails struct {
-	ConnectionSecretNamePrefix string `json:"connectionSecretNamePrefix"`
-	ConnectionSecretNamespace  string `json:"connectionSecretNamespace"`
+	ConnectionSecretNamePrefix string `json:"connectionSecretNamePrefix,omitempty"`
+	ConnectionSecretNamespace  string `json:"connectionSecretNamespace,omitempty"`
 }
 
 // A CivoKubernetesSpec defines the desired state of a CivoKubernetes.
 type CivoKubernetesSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
-	Name              string                        `json:"name"`
-	Pools             []KubernetesClusterPoolConfig `json:"pools"`
+	Name              string `json:"name"`
+	// Pools are the node pools of the cluster, identified by their ID. The
+	// size and public IP setting of a pool cannot be changed; give the pool a
+	// new ID to replace it. The new pool is created before the old one is
+	// deleted.
+	// +listType=map
+	// +listMapKey=id
+	Pools []KubernetesClusterPoolConfig `json:"pools"`
+	// +optional
+	// A list of applications to install from civo marketplace. An application
+	// is configured by giving its plan as name:plan, e.g. "mariadb:5GB", or by
+	// giving its configuration values in ApplicationConfiguration.
+	// Applications added to the list are installed on the existing cluster.
+	// +kubebuilder:validation:items:Pattern=`^[^,:]+(:[^,:]+)?$`
+	Applications []string `json:"applications,omitempty"`
+	// +optional
+	// ApplicationConfiguration holds the configuration values of applications
+	// listed in Applications, keyed by application name, e.g.
+	// {"mariadb": {"VOLUME_SIZE": "5Gi"}}. Civo configures an application
+	// through the plans of the marketplace, so the application is installed
+	// with the plan whose configuration has these values. The values are
+	// applied when the application is installed.
+	ApplicationConfiguration map[string]map[string]string `json:"applicationConfiguration,omitempty"`
 	// +optional
-	// A list of applications to install from civo marketplace.
-	Applications      []string                        `json:"applications,omitempty"`
-	ConnectionDetails CivoKubernetesConnectionDetails `json:"connectionDetails"`
+	// +kubebuilder:validation:Enum=Keep;Uninstall
+	// +kubebuilder:default=Keep
+	// ApplicationRemovalPolicy decides what happens to an application that is
+	// removed from Applications. Keep leaves it installed, Uninstall removes it
+	// from the cluster. Applications Civo installs by default are only
+	// uninstalled if they were listed in Applications.
+	ApplicationRemovalPolicy string `json:"applicationRemovalPolicy,omitempty"`
+	// +optional
+	// Deprecated: Use writeConnectionSecretToRef or publishConnectionDetailsTo instead.
+	ConnectionDetails CivoKubernetesConnectionDetails `json:"connectionDetails,omitempty"`
 	// +optional
 	// +kubebuilder:validation:Enum=flannel;cilium
 	// +kubebuilder:default=flannel
@@ -55,12 +196,75 @@ type CivoKubernetesSpec struct {
 	// NOTE: This can only be set at creation time. Changing this value after creation will not update the CNI.
 	CNIPlugin *string `json:"cni,omitempty"`
 	// +optional
-	// +kubebuilder:default="1.22.2-k3s1"
-	// If not set, the default kubernetes version(1.22.2-k31) will be used.
+	// If not set, Civo's default kubernetes version is used and the cluster keeps its version.
 	// If set, the value must be a valid kubernetes version, you can use the following command to get the valid versions: `civo k3s versions`
 	// Changing the version to a higher version will upgrade the cluster. Note that this may cause breaking changes to the Kubernetes API so please check kubernetes deprecations/mitigations before upgrading.
 	Version *string `json:"version,omitempty"`
 
+	// UpgradePolicy decides which version changes are applied to the cluster.
+	// Manual upgrades the cluster to any newer spec.version. PatchOnly only
+	// allows a newer patch release of the current minor version. Minor also
+	// allows the next minor version. AutoLatestPatch ignores spec.version and
+	// follows the latest patch release of the current minor version that Civo
+	// offers. The cluster is never downgraded. A version change the policy
+	// refuses is reported in the VersionAllowed condition.
+	// +optional
+	// +kubebuilder:validation:Enum=Manual;PatchOnly;Minor;AutoLatestPatch
+	// +kubebuilder:default=Manual
+	UpgradePolicy string `json:"upgradePolicy,omitempty"`
+
+	// NetworkID is the identifier for the network the cluster is created in.
+	// The default network is used when it is not set.
+	// NOTE: This can only be set at creation time.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1.CivoNetwork
+	NetworkID string `json:"networkId,omitempty"`
+
+	// NetworkIDRef references a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDRef *xpv1.Reference `json:"networkIdRef,omitempty"`
+
+	// NetworkIDSelector selects a reference to a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDSelector *xpv1.Selector `json:"networkIdSelector,omitempty"`
+
+	// FirewallID is the identifier for an existing firewall applied to the
+	// cluster's nodes. Conflicts with CreateFirewallRules.
+	// NOTE: This can only be set at creation time.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1.CivoFirewall
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1.FirewallID()
+	FirewallID string `json:"firewallId,omitempty"`
+
+	// FirewallIDRef references a CivoFirewall to retrieve its ID.
+	// +optional
+	FirewallIDRef *xpv1.Reference `json:"firewallIdRef,omitempty"`
+
+	// FirewallIDSelector selects a reference to a CivoFirewall to retrieve its ID.
+	// +optional
+	FirewallIDSelector *xpv1.Selector `json:"firewallIdSelector,omitempty"`
+
+	// CreateFirewallRules are the rules of the firewall Civo creates for the
+	// cluster when no FirewallID is set, in the format of the Civo CLI's
+	// --create-firewall flag, e.g. "443;6443". Civo opens all ports of the
+	// firewall it creates when neither is set.
+	// NOTE: This can only be set at creation time.
+	// +optional
+	// +immutable
+	CreateFirewallRules string `json:"createFirewallRules,omitempty"`
+
+	// Tags of the cluster on Civo, e.g. for cost allocation.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// ProvenanceTags adds the crossplane-name and crossplane-uid tags of the
+	// managed resource to the cluster.
+	// +optional
+	// +kubebuilder:default=true
+	ProvenanceTags *bool `json:"provenanceTags,omitempty"`
+
 	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
 	ProviderReference *xpv1.Reference `json:"providerReference"`
 	// TODO: Update the examples as well
@@ -70,14 +274,15 @@ type CivoKubernetesSpec struct {
 type CivoKubernetesStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          CivoKubernetesObservation `json:"atProvider,omitempty"`
-	Message             string                    `json:"message"`
 }
 
 // +kubebuilder:object:root=true
 
 // A CivoKubernetes is an example API type.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
-// +kubebuilder:printcolumn:name="MESSAGE",type="string",JSONPath=".status.message"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.apiEndpoint",priority=1
 // +kubebuilder:printcolumn:name="APPLICATIONS",type="string",JSONPath=".spec.applications"
 // Please replace `PROVIDER-NAME` with your actual provider name, like `aws`, `azure`, `gcp`, `alibaba`
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
@@ -125,11 +330,267 @@ type CivoKubernetesList struct {
 // Should be converted to the equivalent type in the civogo package before being used.
 // Should always be identical to the KubernetesClusterPoolConfig in the civogo package.
 type KubernetesClusterPoolConfig struct {
-	Region           string            `json:"region,omitempty"`
-	ID               string            `json:"id,omitempty"`
-	Count            int               `json:"count,omitempty"`
-	Size             string            `json:"size,omitempty"`
-	Labels           map[string]string `json:"labels,omitempty"`
-	Taints           []corev1.Taint    `json:"taints"`
-	PublicIPNodePool bool              `json:"public_ip_node_pool,omitempty"`
+	Region string `json:"region,omitempty"`
+	// ID identifies the pool. It is used to match the pool with the one on
+	// Civo, so changing it replaces the pool.
+	// +kubebuilder:validation:Required
+	ID    string `json:"id,omitempty"`
+	Count int    `json:"count,omitempty"`
+	// Size is the Civo size of the pool's nodes. It cannot be changed.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="size is immutable, give the pool a new id to replace it"
+	Size   string            `json:"size,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Taints []corev1.Taint    `json:"taints"`
+	// PublicIPNodePool gives every node of the pool a public IP. It cannot be
+	// changed.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="public_ip_node_pool is immutable, give the pool a new id to replace it"
+	PublicIPNodePool bool `json:"public_ip_node_pool,omitempty"`
+}
+
+// AnnotationKeyReplacedPool records the ID of a pool that a
+// CivoKubernetesNodePool replaced and that still has to be deleted.
+const AnnotationKeyReplacedPool = "cluster.civo.crossplane.io/replaced-pool"
+
+// CivoKubernetesNodePoolSpec defines the desired state of a node pool of a
+// CivoKubernetes cluster.
+type CivoKubernetesNodePoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// ClusterID is the Civo ID of the cluster the pool belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=CivoKubernetes
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1.ClusterID()
+	ClusterID string `json:"clusterId,omitempty"`
+
+	// ClusterIDRef references a CivoKubernetes to retrieve its ID.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIdRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a CivoKubernetes to retrieve its ID.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIdSelector,omitempty"`
+
+	// Size is the Civo size of the pool's nodes, e.g. g4s.kube.medium.
+	// Changing the size replaces the pool.
+	// +kubebuilder:validation:Required
+	Size string `json:"size"`
+
+	// Count is the number of nodes in the pool.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Count int `json:"count,omitempty"`
+
+	// Labels are applied to the pool's nodes.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are applied to the pool's nodes.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+
+	// PublicIPNodePool gives every node of the pool a public IP.
+	// Changing it replaces the pool.
+	// +optional
+	PublicIPNodePool bool `json:"publicIpNodePool,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoKubernetesNodePoolObservation is used to reflect the observed state of the node pool.
+type CivoKubernetesNodePoolObservation struct {
+	// ID is the Civo ID of the pool.
+	ID string `json:"id,omitempty"`
+
+	// ClusterID is the Civo ID of the cluster the pool belongs to.
+	ClusterID string `json:"clusterId,omitempty"`
+
+	// Size is the Civo size of the pool's nodes.
+	Size string `json:"size,omitempty"`
+
+	// Count is the number of nodes in the pool.
+	Count int `json:"count,omitempty"`
+
+	// InstanceNames are the hostnames of the pool's nodes.
+	InstanceNames []string `json:"instanceNames,omitempty"`
+}
+
+// CivoKubernetesNodePoolStatus defines the observed state of CivoKubernetesNodePool.
+type CivoKubernetesNodePoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoKubernetesNodePoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CivoKubernetesNodePool is a node pool of a CivoKubernetes cluster that is
+// managed independently of the cluster's spec.pools.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="string",JSONPath=".status.atProvider.size"
+// +kubebuilder:printcolumn:name="COUNT",type="integer",JSONPath=".status.atProvider.count"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoKubernetesNodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoKubernetesNodePoolSpec   `json:"spec"`
+	Status CivoKubernetesNodePoolStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoKubernetesNodePool) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoKubernetesNodePool) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoKubernetesNodePool) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoKubernetesNodePool) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoKubernetesNodePoolList contains a list of CivoKubernetesNodePool
+type CivoKubernetesNodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoKubernetesNodePool `json:"items"`
+}
+
+// CivoKubernetesApplicationSpec defines the desired state of a marketplace
+// application installed on a CivoKubernetes cluster.
+// +kubebuilder:validation:XValidation:rule="!(has(self.plan) && has(self.configuration))",message="only one of plan and configuration can be set"
+// +kubebuilder:validation:XValidation:rule="has(self.plan) == has(oldSelf.plan) && has(self.configuration) == has(oldSelf.configuration)",message="plan and configuration cannot be added or removed after creation"
+type CivoKubernetesApplicationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// ClusterID is the Civo ID of the cluster the application is installed on.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterId is immutable"
+	// +crossplane:generate:reference:type=CivoKubernetes
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1.ClusterID()
+	ClusterID string `json:"clusterId,omitempty"`
+
+	// ClusterIDRef references a CivoKubernetes to retrieve its ID.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIdRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a CivoKubernetes to retrieve its ID.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIdSelector,omitempty"`
+
+	// Name of the application in the Civo marketplace, e.g. cert-manager.
+	// +kubebuilder:validation:Required
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// Plan of the application, for applications that offer plans, e.g. 5GB.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="plan is immutable"
+	Plan *string `json:"plan,omitempty"`
+
+	// Configuration holds the configuration values of the application, e.g.
+	// {"VOLUME_SIZE": "5Gi"}. Civo configures an application through the plans
+	// of the marketplace, so the application is installed with the plan whose
+	// configuration has these values.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="configuration is immutable"
+	Configuration map[string]string `json:"configuration,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoKubernetesApplicationObservation is used to reflect the observed state of the application.
+type CivoKubernetesApplicationObservation struct {
+	// ClusterID is the Civo ID of the cluster the application is installed on.
+	ClusterID string `json:"clusterId,omitempty"`
+
+	// Name of the application.
+	Name string `json:"name,omitempty"`
+
+	// Plan the application was installed with.
+	Plan string `json:"plan,omitempty"`
+
+	// Version of the application.
+	Version string `json:"version,omitempty"`
+
+	// Installed is true once Civo has finished installing the application.
+	Installed bool `json:"installed,omitempty"`
+}
+
+// CivoKubernetesApplicationStatus defines the observed state of CivoKubernetesApplication.
+type CivoKubernetesApplicationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoKubernetesApplicationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CivoKubernetesApplication is a marketplace application installed on a
+// CivoKubernetes cluster independently of the cluster's spec.applications.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="APPLICATION",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoKubernetesApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoKubernetesApplicationSpec   `json:"spec"`
+	Status CivoKubernetesApplicationStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoKubernetesApplication) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoKubernetesApplication) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoKubernetesApplication) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoKubernetesApplication) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoKubernetesApplicationList contains a list of CivoKubernetesApplication
+type CivoKubernetesApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoKubernetesApplication `json:"items"`
 }
@@ -53,6 +53,140 @@ func (in *CivoKubernetes) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesApplication) DeepCopyInto(out *CivoKubernetesApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesApplication.
+func (in *CivoKubernetesApplication) DeepCopy() *CivoKubernetesApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CivoKubernetesApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesApplicationList) DeepCopyInto(out *CivoKubernetesApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CivoKubernetesApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesApplicationList.
+func (in *CivoKubernetesApplicationList) DeepCopy() *CivoKubernetesApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CivoKubernetesApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesApplicationObservation) DeepCopyInto(out *CivoKubernetesApplicationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesApplicationObservation.
+func (in *CivoKubernetesApplicationObservation) DeepCopy() *CivoKubernetesApplicationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesApplicationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesApplicationSpec) DeepCopyInto(out *CivoKubernetesApplicationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.ClusterIDRef != nil {
+		in, out := &in.ClusterIDRef, &out.ClusterIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterIDSelector != nil {
+		in, out := &in.ClusterIDSelector, &out.ClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(string)
+		**out = **in
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ProviderReference != nil {
+		in, out := &in.ProviderReference, &out.ProviderReference
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesApplicationSpec.
+func (in *CivoKubernetesApplicationSpec) DeepCopy() *CivoKubernetesApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesApplicationStatus) DeepCopyInto(out *CivoKubernetesApplicationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesApplicationStatus.
+func (in *CivoKubernetesApplicationStatus) DeepCopy() *CivoKubernetesApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CivoKubernetesConnectionDetails) DeepCopyInto(out *CivoKubernetesConnectionDetails) {
 	*out = *in
@@ -100,9 +234,177 @@ func (in *CivoKubernetesList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesNodePool) DeepCopyInto(out *CivoKubernetesNodePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesNodePool.
+func (in *CivoKubernetesNodePool) DeepCopy() *CivoKubernetesNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CivoKubernetesNodePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesNodePoolList) DeepCopyInto(out *CivoKubernetesNodePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CivoKubernetesNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesNodePoolList.
+func (in *CivoKubernetesNodePoolList) DeepCopy() *CivoKubernetesNodePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesNodePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CivoKubernetesNodePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesNodePoolObservation) DeepCopyInto(out *CivoKubernetesNodePoolObservation) {
+	*out = *in
+	if in.InstanceNames != nil {
+		in, out := &in.InstanceNames, &out.InstanceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesNodePoolObservation.
+func (in *CivoKubernetesNodePoolObservation) DeepCopy() *CivoKubernetesNodePoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesNodePoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesNodePoolSpec) DeepCopyInto(out *CivoKubernetesNodePoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.ClusterIDRef != nil {
+		in, out := &in.ClusterIDRef, &out.ClusterIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterIDSelector != nil {
+		in, out := &in.ClusterIDSelector, &out.ClusterIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]corev1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProviderReference != nil {
+		in, out := &in.ProviderReference, &out.ProviderReference
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesNodePoolSpec.
+func (in *CivoKubernetesNodePoolSpec) DeepCopy() *CivoKubernetesNodePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesNodePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CivoKubernetesNodePoolStatus) DeepCopyInto(out *CivoKubernetesNodePoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesNodePoolStatus.
+func (in *CivoKubernetesNodePoolStatus) DeepCopy() *CivoKubernetesNodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CivoKubernetesNodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CivoKubernetesObservation) DeepCopyInto(out *CivoKubernetesObservation) {
 	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstalledApplications != nil {
+		in, out := &in.InstalledApplications, &out.InstalledApplications
+		*out = make([]KubernetesApplicationObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedApplications != nil {
+		in, out := &in.ManagedApplications, &out.ManagedApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]KubernetesPoolObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeletingPools != nil {
+		in, out := &in.DeletingPools, &out.DeletingPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesObservation.
@@ -146,6 +448,24 @@ func (in *CivoKubernetesSpec) DeepCopyInto(out *CivoKubernetesSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApplicationConfiguration != nil {
+		in, out := &in.ApplicationConfiguration, &out.ApplicationConfiguration
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	out.ConnectionDetails = in.ConnectionDetails
 	if in.CNIPlugin != nil {
 		in, out := &in.CNIPlugin, &out.CNIPlugin
@@ -157,6 +477,36 @@ func (in *CivoKubernetesSpec) DeepCopyInto(out *CivoKubernetesSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NetworkIDRef != nil {
+		in, out := &in.NetworkIDRef, &out.NetworkIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkIDSelector != nil {
+		in, out := &in.NetworkIDSelector, &out.NetworkIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FirewallIDRef != nil {
+		in, out := &in.FirewallIDRef, &out.FirewallIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FirewallIDSelector != nil {
+		in, out := &in.FirewallIDSelector, &out.FirewallIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProvenanceTags != nil {
+		in, out := &in.ProvenanceTags, &out.ProvenanceTags
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ProviderReference != nil {
 		in, out := &in.ProviderReference, &out.ProviderReference
 		*out = new(v1.Reference)
@@ -178,7 +528,7 @@ func (in *CivoKubernetesSpec) DeepCopy() *CivoKubernetesSpec {
 func (in *CivoKubernetesStatus) DeepCopyInto(out *CivoKubernetesStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoKubernetesStatus.
@@ -191,6 +541,21 @@ func (in *CivoKubernetesStatus) DeepCopy() *CivoKubernetesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesApplicationObservation) DeepCopyInto(out *KubernetesApplicationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesApplicationObservation.
+func (in *KubernetesApplicationObservation) DeepCopy() *KubernetesApplicationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesApplicationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesClusterPoolConfig) DeepCopyInto(out *KubernetesClusterPoolConfig) {
 	*out = *in
@@ -219,3 +584,38 @@ func (in *KubernetesClusterPoolConfig) DeepCopy() *KubernetesClusterPoolConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesInstanceObservation) DeepCopyInto(out *KubernetesInstanceObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesInstanceObservation.
+func (in *KubernetesInstanceObservation) DeepCopy() *KubernetesInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesPoolObservation) DeepCopyInto(out *KubernetesPoolObservation) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]KubernetesInstanceObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesPoolObservation.
+func (in *KubernetesPoolObservation) DeepCopy() *KubernetesPoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesPoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -74,3 +74,115 @@ func (mg *CivoKubernetes) SetProviderReference(r *xpv1.Reference) {
 func (mg *CivoKubernetes) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this CivoKubernetesApplication.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *CivoKubernetesApplication) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this CivoKubernetesApplication.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *CivoKubernetesApplication) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this CivoKubernetesApplication.
+func (mg *CivoKubernetesApplication) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetCondition of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this CivoKubernetesNodePool.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *CivoKubernetesNodePool) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this CivoKubernetesNodePool.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *CivoKubernetesNodePool) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this CivoKubernetesNodePool.
+func (mg *CivoKubernetesNodePool) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
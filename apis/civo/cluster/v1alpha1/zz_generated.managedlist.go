@@ -27,3 +27,21 @@ func (l *CivoKubernetesList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this CivoKubernetesApplicationList.
+func (l *CivoKubernetesApplicationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this CivoKubernetesNodePoolList.
+func (l *CivoKubernetesNodePoolList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CivoDatabaseSpec defines the desired state of a managed database.
+// +kubebuilder:validation:XValidation:rule="has(self.restoreFrom) == has(oldSelf.restoreFrom)",message="restoreFrom can only be set when the database is created"
+type CivoDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name of the database within Civo.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Region is the identifier for the region in which the database is created.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// Engine is the database software.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=MySQL;PostgreSQL
+	// +immutable
+	Engine string `json:"engine"`
+
+	// Version of the database software. Civo picks the default version when it is not set.
+	// +optional
+	// +immutable
+	Version *string `json:"version,omitempty"`
+
+	// Size is the Civo size of the database nodes, e.g. g3.db.small.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Size string `json:"size"`
+
+	// Nodes is the number of nodes of the database.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Nodes int `json:"nodes,omitempty"`
+
+	// NetworkID is the identifier for the network the database is created in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1.CivoNetwork
+	NetworkID string `json:"networkId,omitempty"`
+
+	// NetworkIDRef references a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDRef *xpv1.Reference `json:"networkIdRef,omitempty"`
+
+	// NetworkIDSelector selects a reference to a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDSelector *xpv1.Selector `json:"networkIdSelector,omitempty"`
+
+	// FirewallID is the identifier for the firewall applied to the database.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1.CivoFirewall
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1.FirewallID()
+	FirewallID string `json:"firewallId,omitempty"`
+
+	// FirewallIDRef references a CivoFirewall to retrieve its ID.
+	// +optional
+	FirewallIDRef *xpv1.Reference `json:"firewallIdRef,omitempty"`
+
+	// FirewallIDSelector selects a reference to a CivoFirewall to retrieve its ID.
+	// +optional
+	FirewallIDSelector *xpv1.Selector `json:"firewallIdSelector,omitempty"`
+
+	// FirewallRules are the rules of the firewall Civo creates when no FirewallID is set.
+	// +optional
+	// +immutable
+	FirewallRules string `json:"firewallRules,omitempty"`
+
+	// RestoreFrom restores a backup of another database once this database has
+	// been created. It can only be set when the database is created.
+	// +optional
+	// +immutable
+	RestoreFrom *DatabaseRestore `json:"restoreFrom,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// DatabaseRestore describes the backup a database is restored from.
+type DatabaseRestore struct {
+	// Backup is the name of the backup to restore.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="backup is immutable"
+	Backup string `json:"backup"`
+
+	// DatabaseID is the identifier of the database that owns the backup.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="databaseId is immutable"
+	// +crossplane:generate:reference:type=CivoDatabase
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// DatabaseIDRef references the CivoDatabase that owns the backup.
+	// +optional
+	DatabaseIDRef *xpv1.Reference `json:"databaseIdRef,omitempty"`
+
+	// DatabaseIDSelector selects a reference to the CivoDatabase that owns the
+	// backup.
+	// +optional
+	DatabaseIDSelector *xpv1.Selector `json:"databaseIdSelector,omitempty"`
+}
+
+// CivoDatabaseStatus defines the observed state of CivoDatabase.
+type CivoDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// CivoDatabaseObservation is used to reflect the observed state of the database.
+type CivoDatabaseObservation struct {
+	// ID is the Civo ID of the database.
+	ID string `json:"id,omitempty"`
+
+	// Name of the database.
+	Name string `json:"name,omitempty"`
+
+	// Engine is the database software.
+	Engine string `json:"engine,omitempty"`
+
+	// Version of the database software.
+	Version string `json:"version,omitempty"`
+
+	// Size is the Civo size of the database nodes.
+	Size string `json:"size,omitempty"`
+
+	// Nodes is the number of nodes of the database.
+	Nodes int `json:"nodes,omitempty"`
+
+	// PublicIPv4 is the public IP of the database.
+	PublicIPv4 string `json:"publicIpv4,omitempty"`
+
+	// DNSEntry is the hostname of the database.
+	DNSEntry string `json:"dnsEntry,omitempty"`
+
+	// Port the database listens on.
+	Port int `json:"port,omitempty"`
+
+	// NetworkID is the Civo ID of the network of the database.
+	NetworkID string `json:"networkId,omitempty"`
+
+	// FirewallID is the Civo ID of the firewall applied to the database.
+	FirewallID string `json:"firewallId,omitempty"`
+
+	// Status is the state of the database within Civo.
+	Status string `json:"status,omitempty"`
+
+	// RestoredFrom is the name of the backup the database was restored from.
+	RestoredFrom string `json:"restoredFrom,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDatabase is the Schema for the CivoDatabases API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".status.atProvider.engine"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".status.atProvider.dnsEntry"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoDatabaseSpec   `json:"spec"`
+	Status CivoDatabaseStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoDatabase) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoDatabase) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoDatabase) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoDatabase) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDatabaseList contains a list of CivoDatabase.
+type CivoDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoDatabase `json:"items"`
+}
+
+// CivoDatabaseBackupSpec defines the desired state of a database backup.
+// A backup with a schedule is taken repeatedly by Civo, one without is taken
+// once when it is created.
+type CivoDatabaseBackupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name of the backup, used to restore a database from it.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// Region is the identifier for the region of the database.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// DatabaseID is the identifier of the database to back up.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=CivoDatabase
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// DatabaseIDRef references a CivoDatabase to retrieve its ID.
+	// +optional
+	DatabaseIDRef *xpv1.Reference `json:"databaseIdRef,omitempty"`
+
+	// DatabaseIDSelector selects a reference to a CivoDatabase to retrieve its ID.
+	// +optional
+	DatabaseIDSelector *xpv1.Selector `json:"databaseIdSelector,omitempty"`
+
+	// Schedule is the cron expression of a scheduled backup, e.g. "0 2 * * *".
+	// A manual backup is taken when it is not set.
+	// +optional
+	// +immutable
+	Schedule string `json:"schedule,omitempty"`
+
+	// RetentionCount is the number of backups of a scheduled backup to keep.
+	// Older backups taken by the schedule are deleted. All are kept when it is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RetentionCount *int `json:"retentionCount,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoDatabaseBackupStatus defines the observed state of CivoDatabaseBackup.
+type CivoDatabaseBackupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoDatabaseBackupObservation `json:"atProvider,omitempty"`
+}
+
+// CivoDatabaseBackupObservation is used to reflect the observed state of the backup.
+type CivoDatabaseBackupObservation struct {
+	// ID is the Civo ID of the backup.
+	ID string `json:"id,omitempty"`
+
+	// Name of the backup.
+	Name string `json:"name,omitempty"`
+
+	// DatabaseID is the Civo ID of the backed up database.
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// DatabaseName is the name of the backed up database.
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// Scheduled shows whether this is a scheduled backup.
+	Scheduled bool `json:"scheduled,omitempty"`
+
+	// Schedule is the cron expression of a scheduled backup.
+	Schedule string `json:"schedule,omitempty"`
+
+	// Status is the state of the backup within Civo.
+	Status string `json:"status,omitempty"`
+
+	// CreatedAt is the time the backup was taken.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDatabaseBackup is the Schema for the CivoDatabaseBackups API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="DATABASE",type="string",JSONPath=".status.atProvider.databaseName"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".status.atProvider.schedule"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoDatabaseBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoDatabaseBackupSpec   `json:"spec"`
+	Status CivoDatabaseBackupStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoDatabaseBackup) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoDatabaseBackup) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoDatabaseBackup) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoDatabaseBackup) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDatabaseBackupList contains a list of CivoDatabaseBackup.
+type CivoDatabaseBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoDatabaseBackup `json:"items"`
+}
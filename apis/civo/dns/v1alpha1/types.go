@@ -0,0 +1,227 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CivoDNSDomainSpec defines the desired state of a DNS domain.
+type CivoDNSDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name is the domain name, e.g. example.com.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoDNSDomainStatus defines the observed state of CivoDNSDomain.
+type CivoDNSDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoDNSDomainObservation `json:"atProvider,omitempty"`
+}
+
+// CivoDNSDomainObservation is used to reflect the observed state of the domain.
+type CivoDNSDomainObservation struct {
+	// ID is the Civo ID of the domain.
+	ID string `json:"id,omitempty"`
+
+	// Name is the current name of the domain.
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDNSDomain is the Schema for the CivoDNSDomains API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoDNSDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoDNSDomainSpec   `json:"spec"`
+	Status CivoDNSDomainStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoDNSDomain) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoDNSDomain) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoDNSDomain) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoDNSDomain) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDNSDomainList contains a list of CivoDNSDomain.
+type CivoDNSDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoDNSDomain `json:"items"`
+}
+
+// CivoDNSRecordSpec defines the desired state of a DNS record.
+type CivoDNSRecordSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// DomainID is the identifier of the domain the record belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=CivoDNSDomain
+	DomainID string `json:"domainId,omitempty"`
+
+	// DomainIDRef references a CivoDNSDomain to retrieve its ID.
+	// +optional
+	DomainIDRef *xpv1.Reference `json:"domainIdRef,omitempty"`
+
+	// DomainIDSelector selects a reference to a CivoDNSDomain to retrieve its ID.
+	// +optional
+	DomainIDSelector *xpv1.Selector `json:"domainIdSelector,omitempty"`
+
+	// Type of the record.
+	// +kubebuilder:validation:Enum=A;CNAME;MX;SRV;TXT
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// Name of the record within the domain, e.g. www. Use @ for the domain itself.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Value of the record. It is required unless the value is taken from
+	// InstanceRef or ClusterRef.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// InstanceRef references a CivoInstance whose public IP is used as the
+	// value of the record.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// ClusterRef references a CivoKubernetes whose API endpoint IP is used as
+	// the value of the record.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// Priority of the record, only used by MX and SRV records.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+
+	// TTL of the record in seconds.
+	// +optional
+	// +kubebuilder:default=600
+	TTL int `json:"ttl,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoDNSRecordStatus defines the observed state of CivoDNSRecord.
+type CivoDNSRecordStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoDNSRecordObservation `json:"atProvider,omitempty"`
+}
+
+// CivoDNSRecordObservation is used to reflect the observed state of the record.
+type CivoDNSRecordObservation struct {
+	// ID is the Civo ID of the record.
+	ID string `json:"id,omitempty"`
+
+	// DomainID is the Civo ID of the domain the record belongs to.
+	DomainID string `json:"domainId,omitempty"`
+
+	// Name of the record within the domain.
+	Name string `json:"name,omitempty"`
+
+	// Type of the record.
+	Type string `json:"type,omitempty"`
+
+	// Value of the record.
+	Value string `json:"value,omitempty"`
+
+	// Priority of the record.
+	Priority int `json:"priority,omitempty"`
+
+	// TTL of the record in seconds.
+	TTL int `json:"ttl,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDNSRecord is the Schema for the CivoDNSRecords API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".status.atProvider.value"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoDNSRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoDNSRecordSpec   `json:"spec"`
+	Status CivoDNSRecordStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoDNSRecord) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoDNSRecord) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoDNSRecord) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoDNSRecord) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoDNSRecordList contains a list of CivoDNSRecord.
+type CivoDNSRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoDNSRecord `json:"items"`
+}
@@ -11,13 +11,22 @@ type CivoFirewallSpec struct {
 
 	// Name is the name of the Firewall within Civo.
 	// +kubebuilder:validation:Required
-	// +immutable
 	Name string `json:"name"`
 
 	// NetworkID is the identifier for the network associated with the Firewall.
-	// +kubebuilder:validation:Required
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1.CivoNetwork
+	NetworkID string `json:"networkId,omitempty"`
+
+	// NetworkIDRef references a CivoNetwork to retrieve its ID.
+	// +optional
 	// +immutable
-	NetworkID string `json:"networkId"`
+	NetworkIDRef *xpv1.Reference `json:"networkIdRef,omitempty"`
+
+	// NetworkIDSelector selects a reference to a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDSelector *xpv1.Selector `json:"networkIdSelector,omitempty"`
 
 	// Region is the identifier for the region in which the Firewall is deployed.
 	// +kubebuilder:validation:Required
@@ -76,6 +85,34 @@ type CivoFirewallObservation struct {
 
 	// RulesCount shows how many rules are associated with this firewall.
 	RulesCount int `json:"rulesCount"`
+
+	// Rules are the rules currently applied to the firewall within Civo.
+	// +optional
+	Rules []FirewallRuleObservation `json:"rules,omitempty"`
+}
+
+// FirewallRuleObservation is used to reflect the observed state of a firewall rule.
+type FirewallRuleObservation struct {
+	// ID is the Civo ID of the rule.
+	ID string `json:"id"`
+
+	// Protocol used by the rule.
+	Protocol string `json:"protocol"`
+
+	// StartPort is the starting port of the range.
+	StartPort string `json:"startPort,omitempty"`
+
+	// EndPort is the ending port of the range.
+	EndPort string `json:"endPort,omitempty"`
+
+	// CIDR is the list of IP address ranges that the rule applies to.
+	CIDR []string `json:"cidr,omitempty"`
+
+	// Direction indicates whether the rule is for inbound or outbound traffic.
+	Direction string `json:"direction"`
+
+	// Label is the identifier of the rule.
+	Label string `json:"label,omitempty"`
 }
 
 // +kubebuilder:object:root=true
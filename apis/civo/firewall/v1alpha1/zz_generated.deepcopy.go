@@ -92,6 +92,13 @@ func (in *CivoFirewallObservation) DeepCopyInto(out *CivoFirewallObservation) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallRuleObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoFirewallObservation.
@@ -108,6 +115,16 @@ func (in *CivoFirewallObservation) DeepCopy() *CivoFirewallObservation {
 func (in *CivoFirewallSpec) DeepCopyInto(out *CivoFirewallSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.NetworkIDRef != nil {
+		in, out := &in.NetworkIDRef, &out.NetworkIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkIDSelector != nil {
+		in, out := &in.NetworkIDSelector, &out.NetworkIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]FirewallRule, len(*in))
@@ -168,3 +185,23 @@ func (in *FirewallRule) DeepCopy() *FirewallRule {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleObservation) DeepCopyInto(out *FirewallRuleObservation) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleObservation.
+func (in *FirewallRuleObservation) DeepCopy() *FirewallRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// InstanceID extracts the Civo ID of a CivoInstance from its status. The ID is
+// only known once the instance exists in Civo, so references to a CivoInstance
+// stay unresolved until then.
+func InstanceID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*CivoInstance)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.ID
+	}
+}
+
+// PrivateIP extracts the private IPv4 address of a CivoInstance from its
+// status.
+func PrivateIP() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*CivoInstance)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.PrivateIPv4
+	}
+}
@@ -30,10 +30,30 @@ type CivoInstanceConfig struct {
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
+	// SSHPubKeyRef references a Secret with an SSH public key that is
+	// uploaded to Civo under the hostname of the instance.
+	// Deprecated: Use SSHKeyRef to reference a CivoSSHKey instead.
 	// +immutable
 	// +optional
 	SSHPubKeyRef *SecretReference `json:"sshPubKeyRef,omitempty"`
 
+	// SSHKeyID is the identifier of the SSH key installed on the instance.
+	// The instance never deletes the key, its lifecycle is owned by the CivoSSHKey.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/sshkey/v1alpha1.CivoSSHKey
+	// +crossplane:generate:reference:refFieldName=SSHKeyRef
+	// +crossplane:generate:reference:selectorFieldName=SSHKeySelector
+	SSHKeyID string `json:"sshKeyId,omitempty"`
+
+	// SSHKeyRef references a CivoSSHKey to retrieve its ID.
+	// +optional
+	SSHKeyRef *xpv1.Reference `json:"sshKeyRef,omitempty"`
+
+	// SSHKeySelector selects a reference to a CivoSSHKey to retrieve its ID.
+	// +optional
+	SSHKeySelector *xpv1.Selector `json:"sshKeySelector,omitempty"`
+
 	// +immutable
 	// +optional
 	InitialUser string `json:"initialUser,omitempty"`
@@ -68,6 +88,7 @@ type CivoInstanceObservation struct {
 	ID              string       `json:"id"`
 	State           string       `json:"state,omitempty"`
 	IPv4            string       `json:"ipv4,omitempty"`
+	PrivateIPv4     string       `json:"privateIpv4,omitempty"`
 	ObservableField string       `json:"observableField,omitempty"`
 	CreatedAt       *metav1.Time `json:"createdAt,omitempty"`
 }
@@ -65,6 +65,16 @@ func (in *CivoInstanceConfig) DeepCopyInto(out *CivoInstanceConfig) {
 		*out = new(SecretReference)
 		**out = **in
 	}
+	if in.SSHKeyRef != nil {
+		in, out := &in.SSHKeyRef, &out.SSHKeyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHKeySelector != nil {
+		in, out := &in.SSHKeySelector, &out.SSHKeySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CivoInstanceConfig.
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CivoReservedIPSpec defines the desired state of a reserved IP.
+type CivoReservedIPSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name of the reserved IP within Civo. Civo uses the address as name when it is not set.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Region is the identifier for the region in which the reserved IP is allocated.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// InstanceID is the identifier of the instance the reserved IP is assigned to.
+	// Only one of InstanceID and LoadBalancerID can be set.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1.CivoInstance
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1.InstanceID()
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references a CivoInstance to retrieve its ID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to a CivoInstance to retrieve its ID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// LoadBalancerID is the identifier of the load balancer the reserved IP is assigned to.
+	// Only one of InstanceID and LoadBalancerID can be set. A CivoLoadBalancer
+	// has no reserved IP setting of its own, so this is the only place the
+	// assignment is managed.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/loadbalancer/v1alpha1.CivoLoadBalancer
+	LoadBalancerID string `json:"loadBalancerId,omitempty"`
+
+	// LoadBalancerIDRef references a CivoLoadBalancer to retrieve its ID.
+	// +optional
+	LoadBalancerIDRef *xpv1.Reference `json:"loadBalancerIdRef,omitempty"`
+
+	// LoadBalancerIDSelector selects a reference to a CivoLoadBalancer to retrieve its ID.
+	// +optional
+	LoadBalancerIDSelector *xpv1.Selector `json:"loadBalancerIdSelector,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoReservedIPStatus defines the observed state of CivoReservedIP.
+type CivoReservedIPStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoReservedIPObservation `json:"atProvider,omitempty"`
+}
+
+// CivoReservedIPObservation is used to reflect the observed state of the reserved IP.
+type CivoReservedIPObservation struct {
+	// ID is the Civo ID of the reserved IP.
+	ID string `json:"id,omitempty"`
+
+	// Name of the reserved IP.
+	Name string `json:"name,omitempty"`
+
+	// IP is the reserved address.
+	IP string `json:"ip,omitempty"`
+
+	// AssignedTo is the resource the reserved IP is currently assigned to.
+	AssignedTo *ReservedIPAssignee `json:"assignedTo,omitempty"`
+}
+
+// ReservedIPAssignee describes the resource a reserved IP is assigned to.
+type ReservedIPAssignee struct {
+	// ID is the Civo ID of the resource.
+	ID string `json:"id"`
+
+	// Type of the resource, either instance or loadbalancer.
+	Type string `json:"type"`
+
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoReservedIP is the Schema for the CivoReservedIPs API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ip"
+// +kubebuilder:printcolumn:name="ASSIGNED-TO",type="string",JSONPath=".status.atProvider.assignedTo.name"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoReservedIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoReservedIPSpec   `json:"spec"`
+	Status CivoReservedIPStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoReservedIP) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoReservedIP) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoReservedIP) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoReservedIP) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoReservedIPList contains a list of CivoReservedIP.
+type CivoReservedIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoReservedIP `json:"items"`
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CivoLoadBalancerSpec defines the desired state of a LoadBalancer.
+type CivoLoadBalancerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name is the name of the LoadBalancer within Civo.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Region is the identifier for the region in which the LoadBalancer is created.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// NetworkID is the identifier for the network the LoadBalancer is attached to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1.CivoNetwork
+	NetworkID string `json:"networkId,omitempty"`
+
+	// NetworkIDRef references a CivoNetwork to retrieve its ID.
+	// +optional
+	// +immutable
+	NetworkIDRef *xpv1.Reference `json:"networkIdRef,omitempty"`
+
+	// NetworkIDSelector selects a reference to a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDSelector *xpv1.Selector `json:"networkIdSelector,omitempty"`
+
+	// Algorithm used to spread the traffic over the backends.
+	// +optional
+	// +kubebuilder:validation:Enum=round_robin;least_connections
+	// +kubebuilder:default=round_robin
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// Backends the LoadBalancer forwards traffic to.
+	// +kubebuilder:validation:MinItems=1
+	Backends []LoadBalancerBackend `json:"backends"`
+
+	// FirewallID is the identifier for the firewall applied to the LoadBalancer.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1.CivoFirewall
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1.FirewallID()
+	FirewallID string `json:"firewallId,omitempty"`
+
+	// FirewallIDRef references a CivoFirewall to retrieve its ID.
+	// +optional
+	FirewallIDRef *xpv1.Reference `json:"firewallIdRef,omitempty"`
+
+	// FirewallIDSelector selects a reference to a CivoFirewall to retrieve its ID.
+	// +optional
+	FirewallIDSelector *xpv1.Selector `json:"firewallIdSelector,omitempty"`
+
+	// FirewallRules are the ports Civo opens on the firewall it creates when no FirewallID is set,
+	// e.g. "80,443" or "all".
+	// +optional
+	// +immutable
+	FirewallRules string `json:"firewallRules,omitempty"`
+
+	// MaxConcurrentRequests is the maximum number of concurrent requests the LoadBalancer accepts.
+	// +optional
+	MaxConcurrentRequests *int `json:"maxConcurrentRequests,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// LoadBalancerBackend defines a target the LoadBalancer forwards traffic to.
+type LoadBalancerBackend struct {
+	// IP of the backend. Either set it directly or reference a CivoInstance to use its private IP.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1.CivoInstance
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1.PrivateIP()
+	// +crossplane:generate:reference:refFieldName=InstanceRef
+	// +crossplane:generate:reference:selectorFieldName=InstanceSelector
+	IP string `json:"ip,omitempty"`
+
+	// InstanceRef references a CivoInstance to retrieve its private IP.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CivoInstance to retrieve its private IP.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Protocol used between the LoadBalancer and the backend, e.g. TCP or HTTP.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// SourcePort is the port the LoadBalancer listens on.
+	// +kubebuilder:validation:Required
+	SourcePort int32 `json:"sourcePort"`
+
+	// TargetPort is the port traffic is forwarded to on the backend.
+	// +kubebuilder:validation:Required
+	TargetPort int32 `json:"targetPort"`
+
+	// HealthCheckPort is the port used to check the health of the backend.
+	// +optional
+	HealthCheckPort int32 `json:"healthCheckPort,omitempty"`
+}
+
+// CivoLoadBalancerStatus defines the observed state of CivoLoadBalancer.
+type CivoLoadBalancerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoLoadBalancerObservation `json:"atProvider,omitempty"`
+}
+
+// CivoLoadBalancerObservation is used to reflect the observed state of the load balancer.
+type CivoLoadBalancerObservation struct {
+	// ID is the Civo ID of the LoadBalancer.
+	ID string `json:"id,omitempty"`
+
+	// State of the LoadBalancer within Civo.
+	State string `json:"state,omitempty"`
+
+	// PublicIP is the address the LoadBalancer is reachable on.
+	PublicIP string `json:"publicIp,omitempty"`
+
+	// PrivateIP is the address of the LoadBalancer within its network.
+	PrivateIP string `json:"privateIp,omitempty"`
+
+	// FirewallID is the identifier for the firewall applied to the LoadBalancer.
+	FirewallID string `json:"firewallId,omitempty"`
+
+	// ReservedIP is the reserved IP assigned to the LoadBalancer, if any.
+	// Reserved IPs are assigned with a CivoReservedIP.
+	ReservedIP string `json:"reservedIp,omitempty"`
+
+	// BackendsCount shows how many backends the LoadBalancer forwards traffic to.
+	BackendsCount int `json:"backendsCount"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoLoadBalancer is the Schema for the CivoLoadBalancers API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="PUBLIC-IP",type="string",JSONPath=".status.atProvider.publicIp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoLoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoLoadBalancerSpec   `json:"spec"`
+	Status CivoLoadBalancerStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoLoadBalancer) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoLoadBalancer) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoLoadBalancer) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoLoadBalancer) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoLoadBalancerList contains a list of CivoLoadBalancer.
+type CivoLoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoLoadBalancer `json:"items"`
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CivoNetworkSpec defines the desired state of a private Network.
+type CivoNetworkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Label is the name of the Network within Civo.
+	// +kubebuilder:validation:Required
+	Label string `json:"label"`
+
+	// Region is the identifier for the region in which the Network is created.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// CIDRv4 is the IPv4 range of the Network. Civo picks a free range when it is not set.
+	// +optional
+	// +immutable
+	CIDRv4 *string `json:"cidrV4,omitempty"`
+
+	// NameserversV4 are the IPv4 nameservers handed out to resources in the Network.
+	// +optional
+	NameserversV4 []string `json:"nameserversV4,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoNetworkStatus defines the observed state of CivoNetwork.
+type CivoNetworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoNetworkObservation `json:"atProvider,omitempty"`
+}
+
+// CivoNetworkObservation is used to reflect the observed state of the network.
+type CivoNetworkObservation struct {
+	// ID is the Civo ID of the Network.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name Civo generated for the Network.
+	Name string `json:"name,omitempty"`
+
+	// Label is the current label of the Network.
+	Label string `json:"label,omitempty"`
+
+	// CIDR is the IPv4 range of the Network.
+	CIDR string `json:"cidr,omitempty"`
+
+	// NameserversV4 are the IPv4 nameservers of the Network.
+	NameserversV4 []string `json:"nameserversV4,omitempty"`
+
+	// Default shows whether this is the default Network of the region.
+	Default bool `json:"default,omitempty"`
+
+	// Status is the state of the Network within Civo.
+	Status string `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoNetwork is the Schema for the CivoNetworks API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".status.atProvider.cidr"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoNetworkSpec   `json:"spec"`
+	Status CivoNetworkStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoNetwork) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoNetwork) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoNetwork) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoNetwork) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoNetworkList contains a list of CivoNetwork.
+type CivoNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoNetwork `json:"items"`
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Keys of the connection details published by object store resources. The
+// endpoint is published under xpv1.ResourceCredentialsSecretEndpointKey.
+const (
+	ConnectionKeyBucket          = "bucket"
+	ConnectionKeyAccessKeyID     = "access_key_id"
+	ConnectionKeySecretAccessKey = "secret_access_key"
+)
+
+// Annotations of a CivoObjectStoreCredential.
+const (
+	// AnnotationKeyRotate is the annotation that triggers the rotation of the
+	// secret key whenever it is set to a new, non-empty value.
+	AnnotationKeyRotate = "objectstore.civo.crossplane.io/rotate"
+	// AnnotationKeyCreatedRotation records the value of the rotate annotation
+	// when the credential was created, as a new credential already has a
+	// fresh secret key.
+	AnnotationKeyCreatedRotation = "objectstore.civo.crossplane.io/created-rotation"
+)
+
+// CivoObjectStoreSpec defines the desired state of an object store.
+type CivoObjectStoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name of the object store, which is also the name of its bucket.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// MaxSizeGB is the maximum size of the object store in gigabytes.
+	// +optional
+	// +kubebuilder:default=500
+	// +kubebuilder:validation:Minimum=500
+	MaxSizeGB int64 `json:"maxSizeGB,omitempty"`
+
+	// Region is the identifier for the region in which the object store is created.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// AccessKeyID is the access key of the object store credential that owns
+	// the store. Civo creates a new credential when it is not set.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=CivoObjectStoreCredential
+	// +crossplane:generate:reference:extractor=AccessKeyID()
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+
+	// AccessKeyIDRef references a CivoObjectStoreCredential to retrieve its access key.
+	// +optional
+	AccessKeyIDRef *xpv1.Reference `json:"accessKeyIdRef,omitempty"`
+
+	// AccessKeyIDSelector selects a reference to a CivoObjectStoreCredential to retrieve its access key.
+	// +optional
+	AccessKeyIDSelector *xpv1.Selector `json:"accessKeyIdSelector,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoObjectStoreStatus defines the observed state of CivoObjectStore.
+type CivoObjectStoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoObjectStoreObservation `json:"atProvider,omitempty"`
+}
+
+// CivoObjectStoreObservation is used to reflect the observed state of the object store.
+type CivoObjectStoreObservation struct {
+	// ID is the Civo ID of the object store.
+	ID string `json:"id,omitempty"`
+
+	// Name of the object store.
+	Name string `json:"name,omitempty"`
+
+	// MaxSizeGB is the current maximum size of the object store in gigabytes.
+	MaxSizeGB int `json:"maxSizeGB,omitempty"`
+
+	// Endpoint is the S3 endpoint of the object store.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// AccessKeyID is the access key of the credential that owns the object store.
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+
+	// CredentialID is the Civo ID of the credential that owns the object store.
+	CredentialID string `json:"credentialId,omitempty"`
+
+	// Status is the state of the object store within Civo.
+	Status string `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoObjectStore is the Schema for the CivoObjectStores API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoObjectStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoObjectStoreSpec   `json:"spec"`
+	Status CivoObjectStoreStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoObjectStore) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoObjectStore) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoObjectStore) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoObjectStore) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoObjectStoreList contains a list of CivoObjectStore.
+type CivoObjectStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoObjectStore `json:"items"`
+}
+
+// CivoObjectStoreCredentialSpec defines the desired state of an object store credential.
+type CivoObjectStoreCredentialSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name of the credential within Civo.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// Region is the identifier for the region in which the credential is created.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Region string `json:"region"`
+
+	// AccessKeyID is the access key of the credential. Civo generates one when it is not set.
+	// +optional
+	// +immutable
+	AccessKeyID *string `json:"accessKeyId,omitempty"`
+
+	// MaxSizeGB is the maximum storage in gigabytes the credential may use.
+	// +optional
+	MaxSizeGB *int `json:"maxSizeGB,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoObjectStoreCredentialStatus defines the observed state of CivoObjectStoreCredential.
+type CivoObjectStoreCredentialStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoObjectStoreCredentialObservation `json:"atProvider,omitempty"`
+}
+
+// CivoObjectStoreCredentialObservation is used to reflect the observed state of the credential.
+type CivoObjectStoreCredentialObservation struct {
+	// ID is the Civo ID of the credential.
+	ID string `json:"id,omitempty"`
+
+	// Name of the credential.
+	Name string `json:"name,omitempty"`
+
+	// AccessKeyID is the access key of the credential.
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+
+	// MaxSizeGB is the maximum storage in gigabytes the credential may use.
+	MaxSizeGB int `json:"maxSizeGB,omitempty"`
+
+	// Suspended shows whether Civo suspended the credential.
+	Suspended bool `json:"suspended,omitempty"`
+
+	// Status is the state of the credential within Civo.
+	Status string `json:"status,omitempty"`
+
+	// LastRotation is the value of the rotate annotation the secret key was
+	// last rotated for.
+	LastRotation string `json:"lastRotation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoObjectStoreCredential is the Schema for the CivoObjectStoreCredentials API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ACCESS-KEY",type="string",JSONPath=".status.atProvider.accessKeyId"
+// +kubebuilder:printcolumn:name="LAST-ROTATION",type="string",JSONPath=".status.atProvider.lastRotation"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoObjectStoreCredential struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoObjectStoreCredentialSpec   `json:"spec"`
+	Status CivoObjectStoreCredentialStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoObjectStoreCredential) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoObjectStoreCredential) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoObjectStoreCredential) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoObjectStoreCredential) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoObjectStoreCredentialList contains a list of CivoObjectStoreCredential.
+type CivoObjectStoreCredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoObjectStoreCredential `json:"items"`
+}
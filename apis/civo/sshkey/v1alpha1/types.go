@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CivoSSHKeySpec defines the desired state of an SSH key.
+// +kubebuilder:validation:XValidation:rule="has(self.publicKey) == has(oldSelf.publicKey) && has(self.publicKeySecretRef) == has(oldSelf.publicKeySecretRef)",message="publicKey and publicKeySecretRef cannot be added or removed after creation"
+type CivoSSHKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// Name of the SSH key within Civo.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// PublicKey is the SSH public key, e.g. "ssh-ed25519 AAAA...".
+	// Either PublicKey or PublicKeySecretRef must be set.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="publicKey is immutable"
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// PublicKeySecretRef selects the key of a Secret that holds the SSH public key.
+	// Civo cannot change an uploaded key, so a changed key in the Secret is
+	// reported by the KeyInSync condition instead of being applied.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="publicKeySecretRef is immutable"
+	PublicKeySecretRef *xpv1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
+
+	// ProviderReference holds configs (region, API key etc) for the crossplane provider that is being used.
+	ProviderReference *xpv1.Reference `json:"providerReference,omitempty"`
+}
+
+// CivoSSHKeyStatus defines the observed state of CivoSSHKey.
+type CivoSSHKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CivoSSHKeyObservation `json:"atProvider,omitempty"`
+}
+
+// CivoSSHKeyObservation is used to reflect the observed state of the SSH key.
+type CivoSSHKeyObservation struct {
+	// ID is the Civo ID of the SSH key.
+	ID string `json:"id,omitempty"`
+
+	// Name of the SSH key.
+	Name string `json:"name,omitempty"`
+
+	// Fingerprint of the SSH public key.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CivoSSHKey is the Schema for the CivoSSHKeys API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="FINGERPRINT",type="string",JSONPath=".status.atProvider.fingerprint"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,civo}
+// +kubebuilder:subresource:status
+type CivoSSHKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CivoSSHKeySpec   `json:"spec"`
+	Status CivoSSHKeyStatus `json:"status,omitempty"`
+}
+
+// SetManagementPolicies sets up management policies.
+func (mg *CivoSSHKey) SetManagementPolicies(r xpv1.ManagementPolicies) {}
+
+// GetManagementPolicies gets management policies.
+func (mg *CivoSSHKey) GetManagementPolicies() xpv1.ManagementPolicies {
+	// Note: Crossplane runtime reconciler should leave handling of
+	// ManagementPolicies to the provider controller. This is a temporary hack
+	// until we remove the ManagementPolicy field from the Provider Kubernetes
+	// Object in favor of the one in the ResourceSpec.
+	return []xpv1.ManagementAction{xpv1.ManagementActionAll}
+}
+
+// SetPublishConnectionDetailsTo sets up connection details.
+func (mg *CivoSSHKey) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// GetPublishConnectionDetailsTo gets publish connection details.
+func (mg *CivoSSHKey) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// +kubebuilder:object:root=true
+
+// CivoSSHKeyList contains a list of CivoSSHKey.
+type CivoSSHKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CivoSSHKey `json:"items"`
+}
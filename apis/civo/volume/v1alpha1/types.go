@@ -44,19 +44,49 @@ type CivoVolumeSpec struct {
 	Size int `json:"size"`
 
 	// NetworkID for the network in which you wish to create the volume.
-	// +required
+	// The default network is used when it is not set.
+	// +optional
 	// +immutable
-	// +kubebuilder:validation:Required
-	NetworkID string `json:"network_id"`
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1.CivoNetwork
+	NetworkID string `json:"network_id,omitempty"`
+
+	// NetworkIDRef references a CivoNetwork to retrieve its ID.
+	// +optional
+	// +immutable
+	NetworkIDRef *xpv1.Reference `json:"networkIdRef,omitempty"`
+
+	// NetworkIDSelector selects a reference to a CivoNetwork to retrieve its ID.
+	// +optional
+	NetworkIDSelector *xpv1.Selector `json:"networkIdSelector,omitempty"`
 
 	// ClusterID is the identifier for the cluster to which this volume belongs, if applicable.
 	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1.CivoKubernetes
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1.ClusterID()
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// ClusterIDRef references a CivoKubernetes to retrieve its ID.
+	// +optional
+	ClusterIDRef *xpv1.Reference `json:"clusterIdRef,omitempty"`
+
+	// ClusterIDSelector selects a reference to a CivoKubernetes to retrieve its ID.
+	// +optional
+	ClusterIDSelector *xpv1.Selector `json:"clusterIdSelector,omitempty"`
+
 	// InstanceID is the identifier for the instance to which this volume is attached, if applicable.
 	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1.CivoInstance
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1.InstanceID()
 	InstanceID string `json:"instance_id,omitempty"`
 
+	// InstanceIDRef references a CivoInstance to retrieve its ID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to a CivoInstance to retrieve its ID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
 	// Bootable specifies whether the volume is bootable or not.
 	// +optional
 	Bootable bool `json:"bootable,omitempty"`
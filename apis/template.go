@@ -21,8 +21,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	clusterv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	databasev1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/database/v1alpha1"
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/dns/v1alpha1"
+	firewallv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1"
 	instancev1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1"
+	ipv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/ip/v1alpha1"
+	loadbalancerv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/loadbalancer/v1alpha1"
+	networkv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1"
+	objectstorev1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/objectstore/v1alpha1"
 	providerv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	sshkeyv1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/sshkey/v1alpha1"
 	volumev1alpha1 "github.com/crossplane-contrib/provider-civo/apis/civo/volume/v1alpha1"
 )
 
@@ -33,6 +41,14 @@ func init() {
 		clusterv1alpha1.SchemeBuilder.AddToScheme,
 		instancev1alpha1.SchemeBuilder.AddToScheme,
 		volumev1alpha1.SchemeBuilder.AddToScheme,
+		firewallv1alpha1.SchemeBuilder.AddToScheme,
+		networkv1alpha1.SchemeBuilder.AddToScheme,
+		loadbalancerv1alpha1.SchemeBuilder.AddToScheme,
+		dnsv1alpha1.SchemeBuilder.AddToScheme,
+		ipv1alpha1.SchemeBuilder.AddToScheme,
+		objectstorev1alpha1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
+		sshkeyv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
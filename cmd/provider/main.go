@@ -32,7 +32,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/crossplane-contrib/provider-civo/apis"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civodatabase"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civodatabasebackup"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civodnsdomain"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civodnsrecord"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civofirewall"
 	civokubernetes "github.com/crossplane-contrib/provider-civo/internal/controller/civokubernetes"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civokubernetesapplication"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civokubernetesnodepool"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civoloadbalancer"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civonetwork"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civoobjectstore"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civoobjectstorecredential"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civoreservedip"
+	"github.com/crossplane-contrib/provider-civo/internal/controller/civosshkey"
 	"github.com/crossplane-contrib/provider-civo/internal/controller/civovolume"
 	civoprovider "github.com/crossplane-contrib/provider-civo/internal/controller/provider"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -86,8 +99,21 @@ func main() {
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Template APIs to scheme")
 	kingpin.FatalIfError(civokubernetes.Setup(mgr, log, *rateLimiter), "Cannot setup Civo K3 Cluster controllers")
+	kingpin.FatalIfError(civokubernetesnodepool.Setup(mgr, log, *rateLimiter), "Cannot setup Civo K3 node pool controllers")
+	kingpin.FatalIfError(civokubernetesapplication.Setup(mgr, log, *rateLimiter), "Cannot setup Civo K3 application controllers")
 	kingpin.FatalIfError(civoinstance.Setup(mgr, log, *rateLimiter), "Cannot setup Civo Instance controllers")
 	kingpin.FatalIfError(civovolume.Setup(mgr, log, *rateLimiter), "Cannot setup Civo volume controllers")
+	kingpin.FatalIfError(civofirewall.Setup(mgr, log, *rateLimiter), "Cannot setup Civo firewall controllers")
+	kingpin.FatalIfError(civonetwork.Setup(mgr, log, *rateLimiter), "Cannot setup Civo network controllers")
+	kingpin.FatalIfError(civoloadbalancer.Setup(mgr, log, *rateLimiter), "Cannot setup Civo load balancer controllers")
+	kingpin.FatalIfError(civodnsdomain.Setup(mgr, log, *rateLimiter), "Cannot setup Civo DNS domain controllers")
+	kingpin.FatalIfError(civodnsrecord.Setup(mgr, log, *rateLimiter), "Cannot setup Civo DNS record controllers")
+	kingpin.FatalIfError(civoreservedip.Setup(mgr, log, *rateLimiter), "Cannot setup Civo reserved IP controllers")
+	kingpin.FatalIfError(civoobjectstore.Setup(mgr, log, *rateLimiter), "Cannot setup Civo object store controllers")
+	kingpin.FatalIfError(civoobjectstorecredential.Setup(mgr, log, *rateLimiter), "Cannot setup Civo object store credential controllers")
+	kingpin.FatalIfError(civodatabase.Setup(mgr, log, *rateLimiter), "Cannot setup Civo database controllers")
+	kingpin.FatalIfError(civodatabasebackup.Setup(mgr, log, *rateLimiter), "Cannot setup Civo database backup controllers")
+	kingpin.FatalIfError(civosshkey.Setup(mgr, log, *rateLimiter), "Cannot setup Civo SSH key controllers")
 	kingpin.FatalIfError(civoprovider.Setup(mgr, log, *rateLimiter), "Cannot setup Provider controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
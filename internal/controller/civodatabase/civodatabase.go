@@ -0,0 +1,229 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civodatabase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/database/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoDatabase = "managed resource is not a CivoDatabase"
+	errGetDatabase     = "cannot get database"
+	errCreateDatabase  = "cannot create database"
+	errUpdateDatabase  = "cannot update database"
+	errDeleteDatabase  = "cannot delete database"
+	errRestoreDatabase = "cannot restore database"
+
+	databaseStateReady = "ready"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoDatabase managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoDatabaseGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoDatabaseGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo database ID, which is only known once the
+		// database has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civodatabase", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoDatabase{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	database, ok := mg.(*v1alpha1.CivoDatabase)
+	if !ok {
+		return nil, errors.New(errNotCivoDatabase)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: database.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoDatabase)
+	}
+	civoDatabase, err := e.civoClient.GetDatabase(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetDatabase)
+	}
+	if civoDatabase == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	restoredFrom := cr.Status.AtProvider.RestoredFrom
+	cr.Status.AtProvider = civocli.GenerateDatabaseObservation(civoDatabase)
+	cr.Status.AtProvider.RestoredFrom = restoredFrom
+
+	if !strings.EqualFold(civoDatabase.Status, databaseStateReady) {
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate(cr, civoDatabase) && !restorePending(cr),
+		ConnectionDetails: connectionDetails(civoDatabase),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoDatabase)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	database, err := e.civoClient.CreateDatabase(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDatabase)
+	}
+	cr.Status.AtProvider.ID = database.ID
+	meta.SetExternalName(cr, database.ID)
+	return managed.ExternalCreation{ConnectionDetails: connectionDetails(database)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoDatabase)
+	}
+
+	// The database is left alone while the restore runs; any other change is
+	// applied by a later update.
+	if restorePending(cr) {
+		if err := e.civoClient.RestoreDatabase(cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRestoreDatabase)
+		}
+		cr.Status.AtProvider.RestoredFrom = cr.Spec.RestoreFrom.Backup
+		return managed.ExternalUpdate{}, nil
+	}
+
+	err := e.civoClient.UpdateDatabase(meta.GetExternalName(cr), cr)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDatabase)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoDatabase)
+	if !ok {
+		return errors.New(errNotCivoDatabase)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteDatabase(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteDatabase)
+}
+
+// isUpToDate only compares the fields Civo allows to change after creation.
+func isUpToDate(cr *v1alpha1.CivoDatabase, database *civogo.Database) bool {
+	if cr.Spec.Name != database.Name || cr.Spec.Nodes != database.Nodes {
+		return false
+	}
+	// Civo creates a firewall for the database when none is requested.
+	return cr.Spec.FirewallID == "" || cr.Spec.FirewallID == database.FirewallID
+}
+
+// restorePending reports whether the database still has to be restored from
+// the backup in its spec. Civo can only restore a database that is ready, so
+// the restore is done by the first update after the database became ready.
+// The restore is only requested once, as restoreFrom cannot be changed.
+func restorePending(cr *v1alpha1.CivoDatabase) bool {
+	return cr.Spec.RestoreFrom != nil && cr.Status.AtProvider.RestoredFrom == ""
+}
+
+// connectionDetails prefers the DNS entry of the database over its IP, which
+// changes when the database is recreated.
+func connectionDetails(database *civogo.Database) managed.ConnectionDetails {
+	host := database.DNSEntry
+	if host == "" {
+		host = database.PublicIPv4
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(host),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(database.Port)),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(database.Username),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(database.Password),
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civodatabase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/database/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+)
+
+func TestObserve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/databases/db-building":
+			_, _ = w.Write([]byte(`{"id": "db-building", "name": "orders", "nodes": 1, "status": "Pending"}`))
+		case "/v2/databases/db-ready":
+			_, _ = w.Write([]byte(`{"id": "db-ready", "name": "orders", "nodes": 1, "firewall_id": "fw-civo",
+				"public_ipv4": "192.0.2.30", "dns_entry": "orders.db.civo.com", "port": 5432,
+				"username": "civo", "password": "s3cret", "status": "Ready"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "database_not_found", "reason": "database not found"}`))
+		}
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ready := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("orders.db.civo.com"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("5432"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("civo"),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte("s3cret"),
+	}
+
+	cases := map[string]struct {
+		reason        string
+		id            string
+		nodes         int
+		want          managed.ExternalObservation
+		wantCondition xpv1.Condition
+	}{
+		"DeletedOnCivo": {
+			reason: "A 404 from Civo means the database has to be created again.",
+			id:     "db-gone",
+			nodes:  1,
+		},
+		"StillBuilding": {
+			reason:        "A database that is not ready yet is neither updated nor publishes connection details.",
+			id:            "db-building",
+			nodes:         3,
+			want:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantCondition: xpv1.Creating(),
+		},
+		"ReadyWithCivoFirewall": {
+			reason:        "The firewall Civo created for the database is not drift, and the DNS entry is published as the endpoint.",
+			id:            "db-ready",
+			nodes:         1,
+			want:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: ready},
+			wantCondition: xpv1.Available(),
+		},
+		"ScaledOut": {
+			reason:        "A new node count needs an update.",
+			id:            "db-ready",
+			nodes:         3,
+			want:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: ready},
+			wantCondition: xpv1.Available(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.CivoDatabase{Spec: v1alpha1.CivoDatabaseSpec{Name: "orders", Region: "LON1", Nodes: tc.nodes}}
+			meta.SetExternalName(cr, tc.id)
+			e := &external{civoClient: civoClient}
+
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if tc.wantCondition.Type == "" {
+				return
+			}
+			if got := cr.GetCondition(xpv1.TypeReady); got.Reason != tc.wantCondition.Reason || got.Status != tc.wantCondition.Status {
+				t.Errorf("\n%s\ne.Observe(...): want Ready condition %s/%s, got %s/%s", tc.reason, tc.wantCondition.Status, tc.wantCondition.Reason, got.Status, got.Reason)
+			}
+		})
+	}
+}
+
+func TestUpdateRestoresBackupOnce(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/databases/db-source/restore":
+			_, _ = w.Write([]byte(`{"result": "success"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v2/databases/db-new":
+			_, _ = w.Write([]byte(`{"id": "db-new", "name": "orders", "nodes": 3, "status": "Ready"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "database_not_found", "reason": "database not found"}`))
+		}
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := &v1alpha1.CivoDatabase{Spec: v1alpha1.CivoDatabaseSpec{
+		Name:        "orders",
+		Region:      "LON1",
+		Nodes:       3,
+		RestoreFrom: &v1alpha1.DatabaseRestore{Backup: "nightly", DatabaseID: "db-source"},
+	}}
+	meta.SetExternalName(cr, "db-new")
+	e := &external{civoClient: civoClient}
+
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"POST /v2/databases/db-source/restore"}, requests); diff != "" {
+		t.Errorf("\nThe restore is requested from the database owning the backup, without updating the new database in the same pass.\ne.Update(...): -want, +got:\n%s", diff)
+	}
+	if got := cr.Status.AtProvider.RestoredFrom; got != "nightly" {
+		t.Errorf("\nThe restored backup is recorded so it is not restored again.\ne.Update(...): want RestoredFrom nightly, got %q", got)
+	}
+
+	requests = nil
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"PUT /v2/databases/db-new"}, requests); diff != "" {
+		t.Errorf("\nOnce restored, later changes update the database instead of restoring it again.\ne.Update(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civodatabasebackup
+
+import (
+	"context"
+	"strings"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/database/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoDatabaseBackup = "managed resource is not a CivoDatabaseBackup"
+	errGetBackup             = "cannot get database backup"
+	errListBackups           = "cannot list database backups"
+	errCreateBackup          = "cannot create database backup"
+	errUpdateBackup          = "cannot update database backup"
+	errDeleteBackup          = "cannot delete database backup"
+	errPruneBackup           = "cannot delete expired database backup"
+
+	backupStateCompleted = "completed"
+	backupStateFailed    = "failed"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoDatabaseBackup managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoDatabaseBackupGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoDatabaseBackupGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo backup ID, which is only known once the
+		// backup has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civodatabasebackup", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoDatabaseBackup{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	backup, ok := mg.(*v1alpha1.CivoDatabaseBackup)
+	if !ok {
+		return nil, errors.New(errNotCivoDatabaseBackup)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: backup.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDatabaseBackup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoDatabaseBackup)
+	}
+	civoBackup, err := e.civoClient.GetDatabaseBackup(cr.Spec.DatabaseID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetBackup)
+	}
+	if civoBackup == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateDatabaseBackupObservation(civoBackup)
+
+	switch {
+	case strings.EqualFold(civoBackup.Status, backupStateFailed):
+		cr.SetConditions(xpv1.Unavailable())
+	case civoBackup.IsScheduled, strings.EqualFold(civoBackup.Status, backupStateCompleted):
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	// A manual backup cannot be changed once it has been taken.
+	if !civoBackup.IsScheduled {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	expired, err := e.expiredBackups(cr, civoBackup.ID)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true}, errors.Wrap(err, errListBackups)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: civoBackup.Schedule == cr.Spec.Schedule && len(expired) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDatabaseBackup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoDatabaseBackup)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	backup, err := e.civoClient.CreateDatabaseBackup(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackup)
+	}
+	cr.Status.AtProvider.ID = backup.ID
+	meta.SetExternalName(cr, backup.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoDatabaseBackup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoDatabaseBackup)
+	}
+
+	id := meta.GetExternalName(cr)
+	civoBackup, err := e.civoClient.GetDatabaseBackup(cr.Spec.DatabaseID, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetBackup)
+	}
+	if civoBackup == nil || !civoBackup.IsScheduled {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if civoBackup.Schedule != cr.Spec.Schedule {
+		if err := e.civoClient.UpdateDatabaseBackup(cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackup)
+		}
+	}
+
+	expired, err := e.expiredBackups(cr, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListBackups)
+	}
+	for _, b := range expired {
+		if err := e.civoClient.DeleteDatabaseBackup(cr.Spec.DatabaseID, b.ID); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPruneBackup)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoDatabaseBackup)
+	if !ok {
+		return errors.New(errNotCivoDatabaseBackup)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteDatabaseBackup(cr.Spec.DatabaseID, meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteBackup)
+}
+
+// expiredBackups returns the backups taken by the schedule that exceed the
+// retention count of the spec.
+func (e *external) expiredBackups(cr *v1alpha1.CivoDatabaseBackup, scheduleID string) ([]civogo.DatabaseBackup, error) {
+	if cr.Spec.RetentionCount == nil {
+		return nil, nil
+	}
+	return e.civoClient.ExpiredDatabaseBackups(cr, scheduleID, *cr.Spec.RetentionCount)
+}
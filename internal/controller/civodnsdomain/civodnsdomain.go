@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civodnsdomain
+
+import (
+	"context"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/dns/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoDNSDomain = "managed resource is not a CivoDNSDomain"
+	errGetDNSDomain     = "cannot get DNS domain"
+	errCreateDNSDomain  = "cannot create DNS domain"
+	errUpdateDNSDomain  = "cannot update DNS domain"
+	errDeleteDNSDomain  = "cannot delete DNS domain"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoDNSDomain managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoDNSDomainGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoDNSDomainGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo domain ID, which is only known once the
+		// domain has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civodnsdomain", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoDNSDomain{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	domain, ok := mg.(*v1alpha1.CivoDNSDomain)
+	if !ok {
+		return nil, errors.New(errNotCivoDNSDomain)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: domain.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDNSDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoDNSDomain)
+	}
+	civoDomain, err := e.civoClient.GetDNSDomain(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetDNSDomain)
+	}
+	if civoDomain == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateDNSDomainObservation(civoDomain)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: civoDomain.Name == cr.Spec.Name,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDNSDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoDNSDomain)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	domain, err := e.civoClient.CreateDNSDomain(cr.Spec.Name)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDNSDomain)
+	}
+	cr.Status.AtProvider.ID = domain.ID
+	meta.SetExternalName(cr, domain.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoDNSDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoDNSDomain)
+	}
+
+	civoDomain, err := e.civoClient.GetDNSDomain(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDNSDomain)
+	}
+	if civoDomain == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	err = e.civoClient.RenameDNSDomain(civoDomain, cr.Spec.Name)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDNSDomain)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoDNSDomain)
+	if !ok {
+		return errors.New(errNotCivoDNSDomain)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteDNSDomain(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteDNSDomain)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civodnsrecord
+
+import (
+	"context"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	v1alpha1cluster "github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/dns/v1alpha1"
+	v1alpha1instance "github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoDNSRecord = "managed resource is not a CivoDNSRecord"
+	errGetDNSRecord     = "cannot get DNS record"
+	errCreateDNSRecord  = "cannot create DNS record"
+	errUpdateDNSRecord  = "cannot update DNS record"
+	errDeleteDNSRecord  = "cannot delete DNS record"
+	errGetInstance      = "cannot get referenced CivoInstance"
+	errGetCluster       = "cannot get referenced CivoKubernetes"
+	errInstanceNoIP     = "referenced CivoInstance has no public IP yet"
+	errClusterNoIP      = "referenced CivoKubernetes has no API endpoint IP yet"
+	errNoValue          = "one of value, instanceRef or clusterRef must be set"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoDNSRecord managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoDNSRecordGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoDNSRecordGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo record ID, which is only known once the
+		// record has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civodnsrecord", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoDNSRecord{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	record, ok := mg.(*v1alpha1.CivoDNSRecord)
+	if !ok {
+		return nil, errors.New(errNotCivoDNSRecord)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: record.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDNSRecord)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoDNSRecord)
+	}
+	civoRecord, err := e.civoClient.GetDNSRecord(cr.Spec.DomainID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetDNSRecord)
+	}
+	if civoRecord == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateDNSRecordObservation(civoRecord)
+	cr.SetConditions(xpv1.Available())
+
+	value, err := e.recordValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, civoRecord, value),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoDNSRecord)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoDNSRecord)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	value, err := e.recordValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	record, err := e.civoClient.CreateDNSRecord(cr, value)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDNSRecord)
+	}
+	cr.Status.AtProvider.ID = record.ID
+	meta.SetExternalName(cr, record.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoDNSRecord)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoDNSRecord)
+	}
+
+	civoRecord, err := e.civoClient.GetDNSRecord(cr.Spec.DomainID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDNSRecord)
+	}
+	if civoRecord == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	value, err := e.recordValue(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	err = e.civoClient.UpdateDNSRecord(civoRecord, cr, value)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDNSRecord)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoDNSRecord)
+	if !ok {
+		return errors.New(errNotCivoDNSRecord)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteDNSRecord(cr.Spec.DomainID, meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteDNSRecord)
+}
+
+// recordValue returns the value the record should point to. A referenced
+// CivoInstance or CivoKubernetes takes precedence over the literal value so
+// that the record follows the IP reported in the referenced resource's status.
+func (e *external) recordValue(ctx context.Context, cr *v1alpha1.CivoDNSRecord) (string, error) {
+	switch {
+	case cr.Spec.InstanceRef != nil:
+		instance := &v1alpha1instance.CivoInstance{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.InstanceRef.Name}, instance); err != nil {
+			return "", errors.Wrap(err, errGetInstance)
+		}
+		if instance.Status.AtProvider.IPv4 == "" {
+			return "", errors.New(errInstanceNoIP)
+		}
+		return instance.Status.AtProvider.IPv4, nil
+	case cr.Spec.ClusterRef != nil:
+		cluster := &v1alpha1cluster.CivoKubernetes{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ClusterRef.Name}, cluster); err != nil {
+			return "", errors.Wrap(err, errGetCluster)
+		}
+		if cluster.Status.AtProvider.MasterIP == "" {
+			return "", errors.New(errClusterNoIP)
+		}
+		return cluster.Status.AtProvider.MasterIP, nil
+	case cr.Spec.Value != "":
+		return cr.Spec.Value, nil
+	}
+	return "", errors.New(errNoValue)
+}
+
+func isUpToDate(cr *v1alpha1.CivoDNSRecord, record *civogo.DNSRecord, value string) bool {
+	if cr.Spec.Name != record.Name || cr.Spec.Type != string(record.Type) || value != record.Value {
+		return false
+	}
+	if cr.Spec.Priority != record.Priority {
+		return false
+	}
+	// Civo applies its default TTL when none is requested.
+	return cr.Spec.TTL == 0 || cr.Spec.TTL == record.TTL
+}
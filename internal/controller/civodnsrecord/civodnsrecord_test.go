@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civodnsrecord
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha1cluster "github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/dns/v1alpha1"
+	v1alpha1instance "github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+)
+
+func TestObserve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/dns/example-com/records":
+			_, _ = w.Write([]byte(`[
+				{"id": "www", "domain_id": "example-com", "name": "www", "type": "A", "value": "192.0.2.10", "ttl": 600},
+				{"id": "api", "domain_id": "example-com", "name": "api", "type": "A", "value": "198.51.100.7", "ttl": 600}
+			]`))
+		case "/v2/dns/example-org/records":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "database_dns_domain_not_found", "reason": "domain not found"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kube := &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1alpha1instance.CivoInstance:
+			// The web instance got a new public IP after it was recreated.
+			o.Status.AtProvider.IPv4 = map[string]string{"web": "192.0.2.20", "booting": ""}[key.Name]
+		case *v1alpha1cluster.CivoKubernetes:
+			o.Status.AtProvider.MasterIP = "198.51.100.7"
+		}
+		return nil
+	}}
+
+	cases := map[string]struct {
+		reason   string
+		domainID string
+		id       string
+		spec     v1alpha1.CivoDNSRecordSpec
+		want     managed.ExternalObservation
+		wantErr  bool
+	}{
+		"DomainDeleted": {
+			reason:   "Records are gone together with their domain, so the record has to be created again rather than failing.",
+			domainID: "example-org",
+			id:       "www",
+			spec:     v1alpha1.CivoDNSRecordSpec{Type: "A", Name: "www", Value: "192.0.2.10"},
+		},
+		"LiteralValue": {
+			reason:   "A record with a literal value matching Civo is up to date.",
+			domainID: "example-com",
+			id:       "www",
+			spec:     v1alpha1.CivoDNSRecordSpec{Type: "A", Name: "www", Value: "192.0.2.10", TTL: 600},
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"InstanceIPChanged": {
+			reason:   "A record pointing at a CivoInstance follows the IP in the instance status.",
+			domainID: "example-com",
+			id:       "www",
+			spec:     v1alpha1.CivoDNSRecordSpec{Type: "A", Name: "www", InstanceRef: &xpv1.Reference{Name: "web"}},
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		"InstanceWithoutIP": {
+			reason:   "An instance that has no public IP yet cannot be pointed at.",
+			domainID: "example-com",
+			id:       "www",
+			spec:     v1alpha1.CivoDNSRecordSpec{Type: "A", Name: "www", InstanceRef: &xpv1.Reference{Name: "booting"}},
+			want:     managed.ExternalObservation{ResourceExists: true},
+			wantErr:  true,
+		},
+		"ClusterEndpoint": {
+			reason:   "A record pointing at a CivoKubernetes uses the IP of its API server.",
+			domainID: "example-com",
+			id:       "api",
+			spec:     v1alpha1.CivoDNSRecordSpec{Type: "A", Name: "api", ClusterRef: &xpv1.Reference{Name: "platform"}},
+			want:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.CivoDNSRecord{Spec: tc.spec}
+			cr.Spec.DomainID = tc.domainID
+			meta.SetExternalName(cr, tc.id)
+			e := &external{kube: kube, civoClient: civoClient}
+
+			got, err := e.Observe(context.Background(), cr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ne.Observe(...): want error %t, got %v", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civofirewall
+
+import (
+	"context"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoFirewall    = "managed resource is not a CivoFirewall"
+	errGetFirewall        = "cannot get firewall"
+	errGetFirewallRules   = "cannot get firewall rules"
+	errCreateFirewall     = "cannot create firewall"
+	errUpdateFirewall     = "cannot update firewall"
+	errDeleteFirewall     = "cannot delete firewall"
+	errCreateFirewallRule = "cannot create firewall rule"
+	errDeleteFirewallRule = "cannot delete firewall rule"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoFirewall managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoFirewallGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoFirewallGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo firewall ID, which is only known
+		// once the firewall has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civofirewall", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoFirewall{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	firewall, ok := mg.(*v1alpha1.CivoFirewall)
+	if !ok {
+		return nil, errors.New(errNotCivoFirewall)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: firewall.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoFirewall)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoFirewall)
+	}
+	civoFirewall, err := e.civoClient.GetFirewall(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetFirewall)
+	}
+	if civoFirewall == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rules, err := e.civoClient.GetFirewallRules(civoFirewall.ID)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true}, errors.Wrap(err, errGetFirewallRules)
+	}
+
+	cr.Status.AtProvider = civocli.GenerateFirewallObservation(civoFirewall, rules)
+	cr.SetConditions(xpv1.Available())
+
+	toCreate, toDelete := civocli.DiffFirewallRules(cr.Spec.Rules, rules)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: civoFirewall.Name == cr.Spec.Name && len(toCreate) == 0 && len(toDelete) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoFirewall)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoFirewall)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	firewall, err := e.civoClient.CreateFirewall(cr)
+	if firewall != nil {
+		// Record the ID even if a rule failed so that the firewall is not
+		// created a second time on the next reconcile.
+		cr.Status.AtProvider.ID = firewall.ID
+		meta.SetExternalName(cr, firewall.ID)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFirewall)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoFirewall)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoFirewall)
+	}
+
+	id := meta.GetExternalName(cr)
+	civoFirewall, err := e.civoClient.GetFirewall(id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFirewall)
+	}
+	if civoFirewall == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if civoFirewall.Name != cr.Spec.Name {
+		if err := e.civoClient.RenameFirewall(id, cr.Spec.Name); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFirewall)
+		}
+	}
+
+	rules, err := e.civoClient.GetFirewallRules(id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFirewallRules)
+	}
+
+	// Spec.Rules is the source of truth: missing rules are created first so
+	// that replacing a rule never leaves a gap, then any rule that is not in
+	// the spec is removed.
+	toCreate, toDelete := civocli.DiffFirewallRules(cr.Spec.Rules, rules)
+	for _, rule := range toCreate {
+		if err := e.civoClient.CreateFirewallRule(id, rule); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateFirewallRule)
+		}
+	}
+	for _, rule := range toDelete {
+		if err := e.civoClient.DeleteFirewallRule(id, rule.ID); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteFirewallRule)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoFirewall)
+	if !ok {
+		return errors.New(errNotCivoFirewall)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteFirewall(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteFirewall)
+}
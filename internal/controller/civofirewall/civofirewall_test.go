@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civofirewall
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+)
+
+func TestObserve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/firewalls":
+			_, _ = w.Write([]byte(`[{"id": "fw-1", "name": "web", "instance_count": 2}]`))
+		case "/v2/firewalls/fw-1/rules":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		reason       string
+		externalName string
+		name         string
+		want         managed.ExternalObservation
+		wantID       string
+	}{
+		"NotCreatedYet": {
+			reason: "A firewall without an external name has not been created, so Civo is not searched by name.",
+			name:   "web",
+		},
+		"DeletedOnCivo": {
+			reason:       "A firewall that was removed from Civo must be created again.",
+			externalName: "fw-2",
+			name:         "web",
+		},
+		"Renamed": {
+			reason:       "A new name in the spec is applied by renaming the existing firewall.",
+			externalName: "fw-1",
+			name:         "api",
+			want:         managed.ExternalObservation{ResourceExists: true},
+			wantID:       "fw-1",
+		},
+		"Unchanged": {
+			reason:       "The ID of the firewall is reported in status.",
+			externalName: "fw-1",
+			name:         "web",
+			want:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantID:       "fw-1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.CivoFirewall{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec:       v1alpha1.CivoFirewallSpec{Name: tc.name, Region: "LON1"},
+			}
+			meta.SetExternalName(cr, tc.externalName)
+			e := &external{civoClient: civoClient}
+
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if cr.Status.AtProvider.ID != tc.wantID {
+				t.Errorf("\n%s\ne.Observe(...): want status ID %q, got %q", tc.reason, tc.wantID, cr.Status.AtProvider.ID)
+			}
+		})
+	}
+}
+
+func TestCreateRecordsIDWhenARuleFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/firewalls":
+			_, _ = w.Write([]byte(`{"id": "fw-new", "name": "web", "result": "success"}`))
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"code": "database_firewall_rules_invalid", "reason": "invalid rule"}`))
+		}
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := &v1alpha1.CivoFirewall{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		Spec: v1alpha1.CivoFirewallSpec{
+			Name:   "web",
+			Region: "LON1",
+			Rules:  []v1alpha1.FirewallRule{{Protocol: "tcp", StartPort: 22, CIDR: "0.0.0.0/0", Direction: "ingress", Label: "ssh"}},
+		},
+	}
+	e := &external{civoClient: civoClient}
+
+	if _, err := e.Create(context.Background(), cr); err == nil {
+		t.Errorf("e.Create(...): want an error for the rejected rule")
+	}
+	// Without the ID the next reconcile would create a second firewall.
+	if got := meta.GetExternalName(cr); got != "fw-new" {
+		t.Errorf("e.Create(...): want external name %q, got %q", "fw-new", got)
+	}
+}
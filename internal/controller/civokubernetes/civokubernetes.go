@@ -3,6 +3,8 @@ package civokubernetes
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/civo/civogo"
@@ -10,8 +12,10 @@ import (
 	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
 	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
@@ -27,17 +31,46 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 )
 
+// Statuses of a Civo Kubernetes cluster.
 const (
-	deletionMessage = "Cluster is being deleted"
+	statusActive    = "ACTIVE"
+	statusBuilding  = "BUILDING"
+	statusUpgrading = "UPGRADING"
+	statusScaling   = "SCALING"
+	statusDeleting  = "DELETING"
+	statusError     = "ERROR"
+)
+
+// Condition reasons for a cluster that exists but is not available.
+const (
+	reasonUpgrading     xpv1.ConditionReason = "Upgrading"
+	reasonScaling       xpv1.ConditionReason = "Scaling"
+	reasonClusterError  xpv1.ConditionReason = "ClusterError"
+	reasonUnknownStatus xpv1.ConditionReason = "UnknownStatus"
+)
+
+// typeVersionAllowed is the condition type that reports whether the upgrade
+// policy allows the version change the spec asks for.
+const (
+	typeVersionAllowed   xpv1.ConditionType   = "VersionAllowed"
+	reasonVersionAllowed xpv1.ConditionReason = "Allowed"
+	reasonUpgradeRefused xpv1.ConditionReason = "UpgradeRefused"
+)
+
+const (
+	reasonStatusChanged     event.Reason = "StatusChanged"
+	reasonKubeconfigRotated event.Reason = "KubeconfigRotated"
 )
 
 type connecter struct {
-	client client.Client
+	client   client.Client
+	recorder event.Recorder
 }
 
 type external struct {
 	kube       client.Client
 	civoClient *civocli.CivoClient
+	recorder   event.Recorder
 }
 
 // Setup sets up a Civo Kubernetes controller.
@@ -48,12 +81,22 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) e
 		RateLimiter: &rl,
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CivoKubernetesGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), recorder: recorder}),
+		managed.WithInitializers(
+			managed.NewNameAsExternalName(mgr.GetClient()),
+			&legacyConnectionSecret{kube: mgr.GetClient()}),
+		// Publishing to an External Secret Store only happens when
+		// publishConnectionDetailsTo is set.
+		managed.WithConnectionPublishers(
+			managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
+			connection.NewDetailsManager(mgr.GetClient(), v1alpha1provider.StoreConfigGroupVersionKind)),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(l.WithValues("civokubernetes", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+		managed.WithRecorder(recorder))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -91,6 +134,7 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	return &external{
 		kube:       c.client,
 		civoClient: civoClient,
+		recorder:   c.recorder,
 	}, nil
 }
 
@@ -107,61 +151,115 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if civoCluster == nil {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
-	if strings.Compare(cr.Status.Message, deletionMessage) == 0 {
-		return managed.ExternalObservation{ResourceExists: false}, nil
+	previousStatus := cr.Status.AtProvider.Status
+	previousKubeconfigHash := cr.Status.AtProvider.KubeconfigHash
+	previousManagedApplications := cr.Status.AtProvider.ManagedApplications
+	previousDeletingPools := cr.Status.AtProvider.DeletingPools
+	cr.Status.AtProvider = civocli.GenerateKubernetesObservation(civoCluster)
+	cr.Status.AtProvider.ManagedApplications = managedApplications(previousManagedApplications, cr.Spec.Applications, civoCluster.InstalledApplications)
+	cr.Status.AtProvider.DeletingPools = deletingPools(previousDeletingPools, civoCluster.Pools)
+	e.recordStatusChange(cr, previousStatus, civoCluster)
+	e.recordKubeconfigRotation(cr, previousKubeconfigHash)
+
+	// Civo deletes clusters asynchronously. The cluster is reported as existing
+	// until it is gone so that the finalizer is only removed once Civo confirms.
+	if meta.WasDeleted(cr) {
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
 	}
 
-	switch civoCluster.Status {
-	case "ACTIVE":
-		cr.Status.Message = "Cluster is active"
-		cd, err := connectionDetails([]byte(civoCluster.KubeConfig), civoCluster.Name)
+	// Connection details are returned whenever Civo has a kubeconfig, so a
+	// rotated kubeconfig is republished even while the cluster is busy.
+	var cd managed.ConnectionDetails
+	if civoCluster.KubeConfig != "" {
+		cd, err = connectionDetails([]byte(civoCluster.KubeConfig), civoCluster.Name)
 		if err != nil {
 			return managed.ExternalObservation{ResourceExists: true}, err
 		}
+	}
 
-		// ----------------------------------------------------------------------------
-		secretName := fmt.Sprintf("%s-%s", cr.Spec.ConnectionDetails.ConnectionSecretNamePrefix, cr.Name)
-
-		connectionSecret := &v1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      secretName,
-				Namespace: cr.Spec.ConnectionDetails.ConnectionSecretNamespace,
-			},
-			Data: map[string][]byte{
-				"kubeconfig": []byte(civoCluster.KubeConfig),
-			},
-		}
-		err = e.kube.Get(ctx, types.NamespacedName{
-			Namespace: cr.Spec.ConnectionDetails.ConnectionSecretNamespace,
-			Name:      secretName,
-		}, connectionSecret)
-		if err != nil {
-			err = e.kube.Create(ctx, connectionSecret, &client.CreateOptions{})
-			if err != nil {
-				return managed.ExternalObservation{ResourceExists: true}, err
-			}
-		}
-		// --------------------------------------------
-		_, err = e.Update(ctx, mg)
+	switch civoCluster.Status {
+	case statusActive:
+		upToDate, err := e.isUpToDate(ctx, cr, civoCluster)
 		if err != nil {
-			log.Warnf("update error:%s ", err.Error())
+			return managed.ExternalObservation{ResourceExists: true}, err
 		}
-		// --------------------------------------------
 		cr.SetConditions(xpv1.Available())
 		return managed.ExternalObservation{
 			ResourceExists:    true,
-			ResourceUpToDate:  true,
+			ResourceUpToDate:  upToDate,
 			ConnectionDetails: cd,
 		}, nil
-	case "BUILDING":
-		cr.Status.Message = "Cluster is being created"
+	case statusBuilding:
 		cr.SetConditions(xpv1.Creating())
-		return managed.ExternalObservation{
-			ResourceExists:   true,
-			ResourceUpToDate: true,
-		}, nil
+	case statusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case statusUpgrading:
+		cr.SetConditions(unavailable(reasonUpgrading, "Cluster is being upgraded"))
+	case statusScaling:
+		cr.SetConditions(unavailable(reasonScaling, "Cluster is being scaled"))
+	case statusError:
+		cr.SetConditions(unavailable(reasonClusterError, errorMessage(civoCluster)))
+	default:
+		cr.SetConditions(unavailable(reasonUnknownStatus, fmt.Sprintf("Cluster has unknown status %q", civoCluster.Status)))
+	}
+	// The cluster cannot be changed until Civo has finished working on it, so
+	// it is reported as up to date to hold off Update.
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: cd,
+	}, nil
+}
+
+// recordKubeconfigRotation emits an event when the kubeconfig of a cluster
+// differs from the one last observed, so that consumers of the connection
+// details know to pick up the new credentials.
+func (e *external) recordKubeconfigRotation(cr *v1alpha1.CivoKubernetes, previousHash string) {
+	hash := cr.Status.AtProvider.KubeconfigHash
+	if previousHash == "" || hash == "" || previousHash == hash {
+		return
+	}
+	e.recorder.Event(cr, event.Normal(reasonKubeconfigRotated, "Cluster kubeconfig changed, republishing connection details"))
+}
+
+// recordStatusChange emits an event when the Civo status of a cluster differs
+// from the one last observed. The first observation is not a transition.
+func (e *external) recordStatusChange(cr *v1alpha1.CivoKubernetes, previous string, remote *civogo.KubernetesCluster) {
+	if previous == "" || previous == remote.Status {
+		return
+	}
+	if remote.Status == statusError {
+		e.recorder.Event(cr, event.Warning(reasonStatusChanged, errors.Errorf("cluster status changed from %q to %q: %s", previous, remote.Status, errorMessage(remote))))
+		return
 	}
-	return managed.ExternalObservation{}, nil
+	e.recorder.Event(cr, event.Normal(reasonStatusChanged, fmt.Sprintf("Cluster status changed from %q to %q", previous, remote.Status)))
+}
+
+// unavailable returns an Unavailable condition with the supplied reason and
+// message.
+func unavailable(reason xpv1.ConditionReason, message string) xpv1.Condition {
+	c := xpv1.Unavailable()
+	c.Reason = reason
+	c.Message = message
+	return c
+}
+
+// errorMessage returns the messages of the failing conditions of a cluster.
+func errorMessage(remote *civogo.KubernetesCluster) string {
+	var messages []string
+	for _, c := range remote.Conditions {
+		if c.Status != metav1.ConditionTrue && c.Message != "" {
+			messages = append(messages, c.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return "Cluster is in error state"
+	}
+	return strings.Join(messages, "; ")
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -176,10 +274,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if civoCluster != nil {
 		return managed.ExternalCreation{}, nil
 	}
-	// Convert cr.Spec.Pools to the type expected by civogo package.
-	convertedPools := civocli.ConvertKubernetesClusterPoolConfigs(cr.Spec.Pools)
-	// Create or Update
-	err = e.civoClient.CreateNewK3sCluster(cr.Spec.Name, convertedPools, cr.Spec.Applications, cr.Spec.CNIPlugin, cr.Spec.Version)
+	err = e.civoClient.CreateNewK3sCluster(cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
@@ -203,29 +298,51 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	providerConfig := &v1alpha1provider.ProviderConfig{}
-
-	err = e.kube.Get(ctx, types.NamespacedName{
-		Name: desiredCivoCluster.Spec.ProviderConfigReference.Name}, providerConfig)
+	// Pools managed by CivoKubernetesNodePool resources are not part of the
+	// cluster spec and must be left alone.
+	nodePoolIDs, err := e.nodePoolIDs(ctx, remoteCivoCluster.ID)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
-	if len(desiredCivoCluster.Spec.Pools) != len(remoteCivoCluster.Pools) || !arePoolsEqual(desiredCivoCluster, remoteCivoCluster) {
+	if err := e.updatePools(desiredCivoCluster, remoteCivoCluster, nodePoolIDs); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 
-		log.Debug("Pools are not equal")
-		//TODO: Set region in the civo client once to avoid passing the providerConfig
-		if err := e.civoClient.UpdateK3sCluster(desiredCivoCluster, remoteCivoCluster, providerConfig); err != nil {
+	if apps := missingApplications(desiredCivoCluster.Spec.Applications, remoteCivoCluster.InstalledApplications); len(apps) > 0 {
+		apps, err := e.civoClient.ApplicationPlans(apps, desiredCivoCluster.Spec.ApplicationConfiguration)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		log.Infof("Installing applications %s", strings.Join(apps, ","))
+		if err := e.civoClient.InstallK3sApplications(remoteCivoCluster.ID, apps); err != nil {
 			return managed.ExternalUpdate{}, err
 		}
 	}
 
-	if desiredCivoCluster.Spec.Version != nil {
-		if *desiredCivoCluster.Spec.Version > remoteCivoCluster.Version {
-			log.Info("Updating cluster version")
-			if err := e.civoClient.UpdateK3sClusterVersion(desiredCivoCluster, remoteCivoCluster, providerConfig); err != nil {
-				return managed.ExternalUpdate{}, err
-			}
+	if apps := removedApplications(desiredCivoCluster); len(apps) > 0 {
+		log.Infof("Uninstalling applications %s", strings.Join(apps, ","))
+		if err := e.civoClient.UninstallK3sApplications(remoteCivoCluster.ID, apps); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if tags := civocli.K3sClusterTags(desiredCivoCluster); !areTagsEqual(tags, remoteCivoCluster.Tags) {
+		log.Info("Updating cluster tags")
+		if err := e.civoClient.UpdateK3sClusterTags(remoteCivoCluster.ID, tags); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// A refused version change has already been reported by Observe.
+	available, err := e.availableVersions(desiredCivoCluster)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if version, refused := upgradeTarget(desiredCivoCluster, remoteCivoCluster, available); refused == nil && version != "" {
+		log.Infof("Upgrading cluster from %s to %s", remoteCivoCluster.Version, version)
+		if err := e.civoClient.UpdateK3sClusterVersion(remoteCivoCluster.ID, version); err != nil {
+			return managed.ExternalUpdate{}, err
 		}
 	}
 
@@ -235,50 +352,405 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.CivoKubernetes)
 	if !ok {
-		return nil
+		return errors.New("invalid object")
 	}
+	cr.SetConditions(xpv1.Deleting())
 	civoCluster, err := e.civoClient.GetK3sCluster(cr.Spec.Name)
 	if err != nil {
 		return err
 	}
 	if civoCluster == nil {
-		log.Warnf("Cluster %s does not exist", civoCluster.Name)
+		log.Warnf("Cluster %s does not exist", cr.Spec.Name)
+		return nil
+	}
+
+	// Delete is called on every reconcile until Observe no longer finds the
+	// cluster, so the request is only sent once.
+	if civoCluster.Status == statusDeleting {
 		return nil
 	}
+	return e.civoClient.DeleteK3sCluster(civoCluster.ID)
+}
 
-	// Removing any existing cluster connection details
-	secretName := fmt.Sprintf("%s-%s", cr.Spec.ConnectionDetails.ConnectionSecretNamePrefix, cr.Name)
+// legacyConnectionSecret migrates clusters that still use the deprecated
+// spec.connectionDetails to the standard connection publishing. It defaults
+// writeConnectionSecretToRef to the legacy secret name, and removes the legacy
+// secret so the standard publisher can recreate it as a connection secret
+// controlled by the cluster, with the same kubeconfig key.
+type legacyConnectionSecret struct {
+	kube client.Client
+}
 
-	connectionSecret := &v1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: cr.Spec.ConnectionDetails.ConnectionSecretNamespace,
-		},
+func (l *legacyConnectionSecret) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoKubernetes)
+	if !ok {
+		return errors.New("invalid object")
 	}
-	if err := e.kube.Delete(ctx, connectionSecret); err != nil {
-		return err
+	legacy := cr.Spec.ConnectionDetails
+	if cr.GetWriteConnectionSecretToReference() != nil || cr.GetPublishConnectionDetailsTo() != nil ||
+		legacy.ConnectionSecretNamePrefix == "" || legacy.ConnectionSecretNamespace == "" {
+		return nil
 	}
-	// ------------------------------------------------
-	cr.Status.Message = deletionMessage
-	cr.SetConditions(xpv1.Deleting())
-	return e.civoClient.DeleteK3sCluster(civoCluster.Name)
+
+	ref := &xpv1.SecretReference{
+		Name:      fmt.Sprintf("%s-%s", legacy.ConnectionSecretNamePrefix, cr.Name),
+		Namespace: legacy.ConnectionSecretNamespace,
+	}
+	s := &v1.Secret{}
+	err := l.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s)
+	if client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, "cannot get legacy connection secret")
+	}
+	if err == nil && metav1.GetControllerOf(s) == nil {
+		if err := l.kube.Delete(ctx, s); client.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, "cannot delete legacy connection secret")
+		}
+	}
+
+	cr.SetWriteConnectionSecretToReference(ref)
+	return errors.Wrap(l.kube.Update(ctx, cr), "cannot default writeConnectionSecretToRef")
+}
+
+// isUpToDate reports whether the pools, version, tags and applications of the
+// remote cluster match the spec. It relies on the managed applications
+// observed by Observe.
+func (e *external) isUpToDate(ctx context.Context, cr *v1alpha1.CivoKubernetes, remote *civogo.KubernetesCluster) (bool, error) {
+	nodePoolIDs, err := e.nodePoolIDs(ctx, remote.ID)
+	if err != nil {
+		return false, err
+	}
+	available, err := e.availableVersions(cr)
+	if err != nil {
+		return false, err
+	}
+	// A refused version change is reported in a condition and leaves the
+	// cluster at its current version.
+	version, refused := upgradeTarget(cr, remote, available)
+	if refused != nil {
+		cr.SetConditions(xpv1.Condition{
+			Type:               typeVersionAllowed,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reasonUpgradeRefused,
+			Message:            refused.Error(),
+		})
+	} else {
+		cr.SetConditions(xpv1.Condition{
+			Type:               typeVersionAllowed,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reasonVersionAllowed,
+		})
+	}
+	if version != "" {
+		return false, nil
+	}
+	if !diffPools(cr.Spec.Pools, specPools(remote, nodePoolIDs)).empty() {
+		return false, nil
+	}
+	if !areTagsEqual(civocli.K3sClusterTags(cr), remote.Tags) {
+		return false, nil
+	}
+	return len(missingApplications(cr.Spec.Applications, remote.InstalledApplications)) == 0 &&
+		len(removedApplications(cr)) == 0, nil
+}
+
+// availableVersions returns the versions Civo offers when the upgrade policy
+// follows them, and nil otherwise.
+func (e *external) availableVersions(cr *v1alpha1.CivoKubernetes) ([]string, error) {
+	if cr.Spec.UpgradePolicy != v1alpha1.UpgradePolicyAutoLatestPatch {
+		return nil, nil
+	}
+	versions, err := e.civoClient.ListK3sVersions()
+	return versions, errors.Wrap(err, "cannot list Kubernetes versions")
 }
 
-func arePoolsEqual(desiredCivoCluster *v1alpha1.CivoKubernetes, remoteCivoCluster *civogo.KubernetesCluster) bool {
-	for _, desirePool := range desiredCivoCluster.Spec.Pools {
-		for _, remotePool := range remoteCivoCluster.Pools {
-			if desirePool.ID == remotePool.ID {
-				if desirePool.Count != remotePool.Count {
-					return false
-				}
+// upgradeTarget returns the version the cluster should be upgraded to
+// according to its upgrade policy, or an empty string if it should keep its
+// version. An unset spec.version keeps the current version. It returns an
+// error if the spec asks for a version change the policy does not allow,
+// including any downgrade. A cluster running a version that cannot be parsed
+// is left alone, and Civo versions that cannot be parsed are skipped.
+func upgradeTarget(cr *v1alpha1.CivoKubernetes, remote *civogo.KubernetesCluster, available []string) (string, error) {
+	current, err := civocli.ParseKubernetesVersion(remote.Version)
+	if err != nil {
+		return "", nil
+	}
+
+	if cr.Spec.UpgradePolicy == v1alpha1.UpgradePolicyAutoLatestPatch {
+		target, latest := "", current
+		for _, version := range available {
+			v, err := civocli.ParseKubernetesVersion(version)
+			if err != nil {
+				continue
 			}
+			if v.Major == current.Major && v.Minor == current.Minor && v.Compare(latest) > 0 {
+				target, latest = version, v
+			}
+		}
+		return target, nil
+	}
 
+	if cr.Spec.Version == nil {
+		return "", nil
+	}
+	desired, err := civocli.ParseKubernetesVersion(*cr.Spec.Version)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot upgrade cluster")
+	}
+	switch desired.Compare(current) {
+	case 0:
+		return "", nil
+	case -1:
+		return "", errors.Errorf("refusing to downgrade cluster from %s to %s", remote.Version, *cr.Spec.Version)
+	}
+
+	switch cr.Spec.UpgradePolicy {
+	case v1alpha1.UpgradePolicyPatchOnly:
+		if desired.Major != current.Major || desired.Minor != current.Minor {
+			return "", errors.Errorf("upgrade policy %s does not allow upgrading cluster from %s to %s", cr.Spec.UpgradePolicy, remote.Version, *cr.Spec.Version)
+		}
+	case v1alpha1.UpgradePolicyMinor:
+		if desired.Major != current.Major || desired.Minor > current.Minor+1 {
+			return "", errors.Errorf("upgrade policy %s does not allow upgrading cluster from %s to %s", cr.Spec.UpgradePolicy, remote.Version, *cr.Spec.Version)
 		}
 	}
+	return *cr.Spec.Version, nil
+}
 
+// areTagsEqual reports whether two lists hold the same tags, in any order.
+func areTagsEqual(desired, remote []string) bool {
+	if len(desired) != len(remote) {
+		return false
+	}
+	d := append([]string{}, desired...)
+	r := append([]string{}, remote...)
+	sort.Strings(d)
+	sort.Strings(r)
+	return reflect.DeepEqual(d, r)
+}
+
+// applicationName returns the name of an application given as name or
+// name:plan.
+func applicationName(app string) string {
+	return strings.SplitN(app, ":", 2)[0]
+}
+
+// isApplicationInstalled reports whether an application is installed on the
+// cluster.
+func isApplicationInstalled(name string, installed []civogo.KubernetesInstalledApplication) bool {
+	for _, i := range installed {
+		if strings.EqualFold(i.Name, name) || strings.EqualFold(i.Application, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// missingApplications returns the applications of the spec that are not
+// installed on the cluster. Applications may be given as name:plan.
+func missingApplications(desired []string, installed []civogo.KubernetesInstalledApplication) []string {
+	var missing []string
+	for _, app := range desired {
+		if !isApplicationInstalled(applicationName(app), installed) {
+			missing = append(missing, app)
+		}
+	}
+	return missing
+}
+
+// managedApplications returns the installed applications that are or were
+// listed in the spec. Applications that are no longer installed are dropped.
+func managedApplications(previous, desired []string, installed []civogo.KubernetesInstalledApplication) []string {
+	var apps []string
+	seen := map[string]bool{}
+	for _, name := range previous {
+		if !seen[name] && isApplicationInstalled(name, installed) {
+			seen[name] = true
+			apps = append(apps, name)
+		}
+	}
+	for _, app := range desired {
+		name := applicationName(app)
+		if !seen[name] && isApplicationInstalled(name, installed) {
+			seen[name] = true
+			apps = append(apps, name)
+		}
+	}
+	return apps
+}
+
+// removedApplications returns the managed applications that are no longer
+// listed in the spec and have to be uninstalled according to the removal
+// policy.
+func removedApplications(cr *v1alpha1.CivoKubernetes) []string {
+	if cr.Spec.ApplicationRemovalPolicy != v1alpha1.ApplicationRemovalPolicyUninstall {
+		return nil
+	}
+	var removed []string
+	for _, name := range cr.Status.AtProvider.ManagedApplications {
+		listed := false
+		for _, app := range cr.Spec.Applications {
+			if strings.EqualFold(applicationName(app), name) {
+				listed = true
+				break
+			}
+		}
+		if !listed {
+			removed = append(removed, name)
+		}
+	}
+	return removed
+}
+
+// nodePoolIDs returns the IDs of the pools of a cluster that are managed by
+// CivoKubernetesNodePool resources.
+func (e *external) nodePoolIDs(ctx context.Context, clusterID string) ([]string, error) {
+	l := &v1alpha1.CivoKubernetesNodePoolList{}
+	if err := e.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, "cannot list node pools")
+	}
+	var ids []string
+	for i := range l.Items {
+		if id := meta.GetExternalName(&l.Items[i]); l.Items[i].Spec.ClusterID == clusterID && id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// specPools returns the remote pools of a cluster that are not managed by
+// CivoKubernetesNodePool resources.
+func specPools(remoteCivoCluster *civogo.KubernetesCluster, nodePoolIDs []string) []civogo.KubernetesPool {
+	var pools []civogo.KubernetesPool
+	for _, pool := range remoteCivoCluster.Pools {
+		owned := false
+		for _, id := range nodePoolIDs {
+			if pool.ID == id {
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
+}
+
+// poolOperations are the changes needed to bring the pools of a cluster in
+// line with its spec.
+type poolOperations struct {
+	create []v1alpha1.KubernetesClusterPoolConfig
+	update []v1alpha1.KubernetesClusterPoolConfig
+	delete []string
+}
+
+func (o poolOperations) empty() bool {
+	return len(o.create) == 0 && len(o.update) == 0 && len(o.delete) == 0
+}
+
+// diffPools compares the pools of the cluster spec with the remote pools that
+// are not managed by CivoKubernetesNodePool resources. The size and public IP
+// setting of a pool are immutable in the spec, so a pool is only replaced when
+// its ID changes.
+func diffPools(desired []v1alpha1.KubernetesClusterPoolConfig, remote []civogo.KubernetesPool) poolOperations {
+	ops := poolOperations{}
+	remoteByID := make(map[string]civogo.KubernetesPool, len(remote))
+	for _, pool := range remote {
+		remoteByID[pool.ID] = pool
+	}
+	desiredIDs := make(map[string]bool, len(desired))
+	for _, pool := range desired {
+		desiredIDs[pool.ID] = true
+		remotePool, ok := remoteByID[pool.ID]
+		switch {
+		case !ok:
+			ops.create = append(ops.create, pool)
+		case !isPoolUpToDate(pool, remotePool):
+			ops.update = append(ops.update, pool)
+		}
+	}
+	for _, pool := range remote {
+		if !desiredIDs[pool.ID] {
+			ops.delete = append(ops.delete, pool.ID)
+		}
+	}
+	return ops
+}
+
+// isPoolUpToDate reports whether the fields of a pool that can be changed in
+// place match the spec.
+func isPoolUpToDate(desired v1alpha1.KubernetesClusterPoolConfig, remote civogo.KubernetesPool) bool {
+	if desired.Count != remote.Count {
+		return false
+	}
+	if (len(desired.Labels) != 0 || len(remote.Labels) != 0) && !reflect.DeepEqual(desired.Labels, remote.Labels) {
+		return false
+	}
+	if (len(desired.Taints) != 0 || len(remote.Taints) != 0) && !reflect.DeepEqual(desired.Taints, remote.Taints) {
+		return false
+	}
 	return true
 }
 
+// updatePools applies the pool changes of the cluster spec one pool at a time.
+// New pools are created first so that a pool replaced under a new ID has its
+// capacity added before the old pool is removed. The deletion of a pool is
+// only requested once; the pool is left alone while Civo removes it.
+func (e *external) updatePools(desired *v1alpha1.CivoKubernetes, remote *civogo.KubernetesCluster, nodePoolIDs []string) error {
+	ops := diffPools(desired.Spec.Pools, specPools(remote, nodePoolIDs))
+	for _, pool := range ops.create {
+		log.Debugf("Creating pool %s", pool.ID)
+		if err := e.civoClient.CreateK3sClusterPool(remote.ID, pool); err != nil {
+			return errors.Wrapf(err, "cannot create pool %s", pool.ID)
+		}
+	}
+	for _, pool := range ops.update {
+		log.Debugf("Updating pool %s", pool.ID)
+		if err := e.civoClient.UpdateK3sClusterPool(remote.ID, pool); err != nil {
+			return errors.Wrapf(err, "cannot update pool %s", pool.ID)
+		}
+	}
+	for _, id := range ops.delete {
+		if isPoolDeleting(desired, id) {
+			continue
+		}
+		log.Debugf("Deleting pool %s", id)
+		if err := e.civoClient.DeleteKubernetesNodePool(remote.ID, id); err != nil {
+			return errors.Wrapf(err, "cannot delete pool %s", id)
+		}
+		desired.Status.AtProvider.DeletingPools = append(desired.Status.AtProvider.DeletingPools, id)
+	}
+	return nil
+}
+
+// isPoolDeleting reports whether the deletion of a pool has already been
+// requested.
+func isPoolDeleting(cr *v1alpha1.CivoKubernetes, id string) bool {
+	for _, deleting := range cr.Status.AtProvider.DeletingPools {
+		if deleting == id {
+			return true
+		}
+	}
+	return false
+}
+
+// deletingPools returns the pools whose deletion was requested and that still
+// exist on Civo.
+func deletingPools(previous []string, pools []civogo.KubernetesPool) []string {
+	var ids []string
+	for _, id := range previous {
+		for _, pool := range pools {
+			if pool.ID == id {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids
+}
+
 func connectionDetails(kubeconfig []byte, name string) (managed.ConnectionDetails, error) {
 	kcfg, err := clientcmd.Load(kubeconfig)
 	if err != nil {
@@ -0,0 +1,298 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civokubernetes
+
+import (
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+)
+
+func TestDiffPools(t *testing.T) {
+	small := v1alpha1.KubernetesClusterPoolConfig{ID: "small", Count: 2, Size: "g4s.kube.small"}
+	large := v1alpha1.KubernetesClusterPoolConfig{ID: "large", Count: 1, Size: "g4s.kube.large"}
+	remoteSmall := civogo.KubernetesPool{ID: "small", Count: 2, Size: "g4s.kube.small"}
+	remoteLarge := civogo.KubernetesPool{ID: "large", Count: 1, Size: "g4s.kube.large"}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1alpha1.KubernetesClusterPoolConfig
+		remote  []civogo.KubernetesPool
+		want    poolOperations
+	}{
+		"Unchanged": {
+			reason:  "Pools are matched by ID, not by their position in the spec.",
+			desired: []v1alpha1.KubernetesClusterPoolConfig{large, small},
+			remote:  []civogo.KubernetesPool{remoteSmall, remoteLarge},
+		},
+		"AddLargePool": {
+			reason:  "A pool in the spec that Civo does not have should be created.",
+			desired: []v1alpha1.KubernetesClusterPoolConfig{small, large},
+			remote:  []civogo.KubernetesPool{remoteSmall},
+			want:    poolOperations{create: []v1alpha1.KubernetesClusterPoolConfig{large}},
+		},
+		"DropLargePool": {
+			reason:  "A pool that is no longer in the spec should be deleted.",
+			desired: []v1alpha1.KubernetesClusterPoolConfig{small},
+			remote:  []civogo.KubernetesPool{remoteSmall, remoteLarge},
+			want:    poolOperations{delete: []string{"large"}},
+		},
+		"ScaleUp": {
+			reason:  "A count change should be applied to the existing pool.",
+			desired: []v1alpha1.KubernetesClusterPoolConfig{{ID: "small", Count: 3, Size: "g4s.kube.small"}},
+			remote:  []civogo.KubernetesPool{remoteSmall},
+			want:    poolOperations{update: []v1alpha1.KubernetesClusterPoolConfig{{ID: "small", Count: 3, Size: "g4s.kube.small"}}},
+		},
+		"SizeDriftIsNotReplaced": {
+			reason:  "The size of a pool is immutable in the spec, so a size mismatch must never delete the pool.",
+			desired: []v1alpha1.KubernetesClusterPoolConfig{{ID: "small", Count: 2, Size: "g4s.kube.medium"}},
+			remote:  []civogo.KubernetesPool{remoteSmall},
+		},
+		"ReplaceUnderNewID": {
+			reason:  "Moving a pool to a new ID should create the new pool alongside the deletion of the old one.",
+			desired: []v1alpha1.KubernetesClusterPoolConfig{{ID: "small-v2", Count: 2, Size: "g4s.kube.medium"}},
+			remote:  []civogo.KubernetesPool{remoteSmall},
+			want: poolOperations{
+				create: []v1alpha1.KubernetesClusterPoolConfig{{ID: "small-v2", Count: 2, Size: "g4s.kube.medium"}},
+				delete: []string{"small"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := diffPools(tc.desired, tc.remote)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(poolOperations{})); diff != "" {
+				t.Errorf("\n%s\ndiffPools(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if got.empty() != tc.want.empty() {
+				t.Errorf("diffPools(...).empty(): want %t, got %t", tc.want.empty(), got.empty())
+			}
+		})
+	}
+}
+
+func TestIsPoolUpToDate(t *testing.T) {
+	taint := corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}
+
+	cases := map[string]struct {
+		desired v1alpha1.KubernetesClusterPoolConfig
+		remote  civogo.KubernetesPool
+		want    bool
+	}{
+		"UpToDate": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2, Labels: map[string]string{"role": "web"}, Taints: []corev1.Taint{taint}},
+			remote:  civogo.KubernetesPool{Count: 2, Labels: map[string]string{"role": "web"}, Taints: []corev1.Taint{taint}},
+			want:    true,
+		},
+		"CountChanged": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 3},
+			remote:  civogo.KubernetesPool{Count: 2},
+			want:    false,
+		},
+		"EmptyLabelsMatchNilLabels": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2, Labels: map[string]string{}},
+			remote:  civogo.KubernetesPool{Count: 2},
+			want:    true,
+		},
+		"EmptyTaintsMatchNilTaints": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2, Taints: []corev1.Taint{}},
+			remote:  civogo.KubernetesPool{Count: 2},
+			want:    true,
+		},
+		"LabelAdded": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2, Labels: map[string]string{"role": "web"}},
+			remote:  civogo.KubernetesPool{Count: 2},
+			want:    false,
+		},
+		"LabelRemoved": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2},
+			remote:  civogo.KubernetesPool{Count: 2, Labels: map[string]string{"role": "web"}},
+			want:    false,
+		},
+		"TaintRemoved": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2, Taints: []corev1.Taint{}},
+			remote:  civogo.KubernetesPool{Count: 2, Taints: []corev1.Taint{taint}},
+			want:    false,
+		},
+		"SizeIsIgnored": {
+			desired: v1alpha1.KubernetesClusterPoolConfig{Count: 2, Size: "g4s.kube.medium"},
+			remote:  civogo.KubernetesPool{Count: 2, Size: "g4s.kube.small"},
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isPoolUpToDate(tc.desired, tc.remote); got != tc.want {
+				t.Errorf("isPoolUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDeletingPools(t *testing.T) {
+	cases := map[string]struct {
+		previous []string
+		pools    []civogo.KubernetesPool
+		want     []string
+	}{
+		"NoneDeleting": {
+			pools: []civogo.KubernetesPool{{ID: "small"}},
+		},
+		"StillDeleting": {
+			previous: []string{"small"},
+			pools:    []civogo.KubernetesPool{{ID: "small"}, {ID: "large"}},
+			want:     []string{"small"},
+		},
+		"Deleted": {
+			previous: []string{"small", "large"},
+			pools:    []civogo.KubernetesPool{{ID: "large"}},
+			want:     []string{"large"},
+		},
+		"AllDeleted": {
+			previous: []string{"small"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, deletingPools(tc.previous, tc.pools)); diff != "" {
+				t.Errorf("deletingPools(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeTarget(t *testing.T) {
+	cluster := func(policy, version string) *v1alpha1.CivoKubernetes {
+		cr := &v1alpha1.CivoKubernetes{}
+		cr.Spec.UpgradePolicy = policy
+		if version != "" {
+			cr.Spec.Version = &version
+		}
+		return cr
+	}
+
+	type want struct {
+		version string
+		refused bool
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.CivoKubernetes
+		remote    string
+		available []string
+		want      want
+	}{
+		"UnsetVersionKeepsCurrent": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, ""),
+			remote: "1.28.7-k3s1",
+		},
+		"SameVersion": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "1.28.7-k3s1"),
+			remote: "1.28.7-k3s1",
+		},
+		"ManualUpgrade": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "1.29.2-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{version: "1.29.2-k3s1"},
+		},
+		"EmptyPolicyIsManual": {
+			cr:     cluster("", "1.30.0-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{version: "1.30.0-k3s1"},
+		},
+		"NotComparedAsStrings": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "1.22.2-k3s1"),
+			remote: "1.9.0-k3s1",
+			want:   want{version: "1.22.2-k3s1"},
+		},
+		"Downgrade": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "1.22.2-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{refused: true},
+		},
+		"RevisionDowngrade": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "1.28.7-k3s1"),
+			remote: "1.28.7-k3s2",
+			want:   want{refused: true},
+		},
+		"PatchOnlyAllowsPatch": {
+			cr:     cluster(v1alpha1.UpgradePolicyPatchOnly, "1.28.9-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{version: "1.28.9-k3s1"},
+		},
+		"PatchOnlyRefusesMinor": {
+			cr:     cluster(v1alpha1.UpgradePolicyPatchOnly, "1.29.2-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{refused: true},
+		},
+		"MinorAllowsNextMinor": {
+			cr:     cluster(v1alpha1.UpgradePolicyMinor, "1.29.2-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{version: "1.29.2-k3s1"},
+		},
+		"MinorRefusesSkippedMinor": {
+			cr:     cluster(v1alpha1.UpgradePolicyMinor, "1.30.0-k3s1"),
+			remote: "1.28.7-k3s1",
+			want:   want{refused: true},
+		},
+		"MinorRefusesMajor": {
+			cr:     cluster(v1alpha1.UpgradePolicyMinor, "2.0.0"),
+			remote: "1.28.7-k3s1",
+			want:   want{refused: true},
+		},
+		"InvalidDesiredVersion": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "latest"),
+			remote: "1.28.7-k3s1",
+			want:   want{refused: true},
+		},
+		"InvalidRemoteVersionKeepsCurrent": {
+			cr:     cluster(v1alpha1.UpgradePolicyManual, "1.29.2-k3s1"),
+			remote: "unknown",
+		},
+		"AutoLatestPatch": {
+			cr:        cluster(v1alpha1.UpgradePolicyAutoLatestPatch, "1.22.2-k3s1"),
+			remote:    "1.28.7-k3s1",
+			available: []string{"1.27.9-k3s1", "1.28.9-k3s1", "1.28.8-k3s1", "1.29.2-k3s1", "invalid"},
+			want:      want{version: "1.28.9-k3s1"},
+		},
+		"AutoLatestPatchUpToDate": {
+			cr:        cluster(v1alpha1.UpgradePolicyAutoLatestPatch, ""),
+			remote:    "1.28.9-k3s1",
+			available: []string{"1.28.7-k3s1", "1.28.9-k3s1", "1.29.2-k3s1"},
+		},
+		"AutoLatestPatchNoVersions": {
+			cr:     cluster(v1alpha1.UpgradePolicyAutoLatestPatch, ""),
+			remote: "1.28.7-k3s1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := upgradeTarget(tc.cr, &civogo.KubernetesCluster{Version: tc.remote}, tc.available)
+			if (err != nil) != tc.want.refused {
+				t.Fatalf("upgradeTarget(...): want refused %t, got %v", tc.want.refused, err)
+			}
+			if got != tc.want.version {
+				t.Errorf("upgradeTarget(...): want %q, got %q", tc.want.version, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civokubernetesapplication
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoKubernetesApplication = "managed resource is not a CivoKubernetesApplication"
+	errGetApplication               = "cannot get application"
+	errInstallApplication           = "cannot install application"
+	errUninstallApplication         = "cannot uninstall application"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoKubernetesApplication managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoKubernetesApplicationGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoKubernetesApplicationGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the name of the application in the Civo
+		// marketplace.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civokubernetesapplication", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoKubernetesApplication{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	application, ok := mg.(*v1alpha1.CivoKubernetesApplication)
+	if !ok {
+		return nil, errors.New(errNotCivoKubernetesApplication)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: application.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesApplication)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoKubernetesApplication)
+	}
+	app, err := e.civoClient.GetK3sClusterApplication(cr.Spec.ClusterID, cr.Spec.Name)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetApplication)
+	}
+	if app == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateKubernetesApplicationObservation(cr.Spec.ClusterID, app)
+	switch {
+	case meta.WasDeleted(cr):
+		cr.SetConditions(xpv1.Deleting())
+	case app.Installed:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	// The name, plan and configuration of an application cannot be changed
+	// once installed.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesApplication)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoKubernetesApplication)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	app := cr.Spec.Name
+	if cr.Spec.Plan != nil {
+		app = fmt.Sprintf("%s:%s", app, *cr.Spec.Plan)
+	}
+	apps, err := e.civoClient.ApplicationPlans([]string{app}, map[string]map[string]string{cr.Spec.Name: cr.Spec.Configuration})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInstallApplication)
+	}
+	if err := e.civoClient.InstallK3sApplications(cr.Spec.ClusterID, apps); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInstallApplication)
+	}
+	meta.SetExternalName(cr, cr.Spec.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesApplication)
+	if !ok {
+		return errors.New(errNotCivoKubernetesApplication)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	app, err := e.civoClient.GetK3sClusterApplication(cr.Spec.ClusterID, cr.Spec.Name)
+	if err != nil {
+		return errors.Wrap(err, errGetApplication)
+	}
+	if app == nil {
+		return nil
+	}
+	err = e.civoClient.UninstallK3sApplications(cr.Spec.ClusterID, []string{cr.Spec.Name})
+	return errors.Wrap(err, errUninstallApplication)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civokubernetesapplication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+)
+
+const marketplace = `[
+	{"name": "MariaDB", "plans": [
+		{"label": "5GB", "configuration": {"VOLUME_SIZE": {"value": "5Gi"}}},
+		{"label": "10GB", "configuration": {"VOLUME_SIZE": {"value": "10Gi"}}}
+	]},
+	{"name": "cert-manager"}
+]`
+
+func TestCreatePicksPlan(t *testing.T) {
+	plan := "5GB"
+
+	cases := map[string]struct {
+		reason        string
+		plan          *string
+		configuration map[string]string
+		want          []string
+		wantErr       bool
+	}{
+		"SizedByVolume": {
+			reason:        "The plan whose configuration has the values is installed.",
+			configuration: map[string]string{"VOLUME_SIZE": "10Gi"},
+			want:          []string{"GET /v2/kubernetes/applications", "PUT /v2/kubernetes/clusters/c-1 mariadb:10GB"},
+		},
+		"PlanByLabel": {
+			reason: "A plan given by its label is installed without looking up the marketplace.",
+			plan:   &plan,
+			want:   []string{"PUT /v2/kubernetes/clusters/c-1 mariadb:5GB"},
+		},
+		"VolumeSizeNotOffered": {
+			reason:        "Values that no plan offers are refused instead of installing the default plan.",
+			configuration: map[string]string{"VOLUME_SIZE": "1Ti"},
+			want:          []string{"GET /v2/kubernetes/applications"},
+			wantErr:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/v2/kubernetes/applications":
+					requests = append(requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+					_, _ = w.Write([]byte(marketplace))
+				case r.Method == http.MethodPut && r.URL.Path == "/v2/kubernetes/clusters/c-1":
+					cfg := civogo.KubernetesClusterConfig{}
+					_ = json.NewDecoder(r.Body).Decode(&cfg)
+					requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, cfg.Applications))
+					_, _ = w.Write([]byte(`{"id": "c-1"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			cr := &v1alpha1.CivoKubernetesApplication{Spec: v1alpha1.CivoKubernetesApplicationSpec{
+				ClusterID:     "c-1",
+				Name:          "mariadb",
+				Plan:          tc.plan,
+				Configuration: tc.configuration,
+			}}
+			e := &external{civoClient: civoClient}
+
+			_, err = e.Create(context.Background(), cr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\ne.Create(...): want error %t, got %v", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, requests); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveReportsInstalledPlan(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "c-1", "installed_applications": [
+			{"application": "mariadb", "name": "MariaDB", "plan": "10GB", "version": "10.4.7", "installed": true}
+		]}`))
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := &v1alpha1.CivoKubernetesApplication{Spec: v1alpha1.CivoKubernetesApplicationSpec{
+		ClusterID:     "c-1",
+		Name:          "mariadb",
+		Configuration: map[string]string{"VOLUME_SIZE": "10Gi"},
+	}}
+	e := &external{civoClient: civoClient}
+
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %v", err)
+	}
+	if !got.ResourceExists || !got.ResourceUpToDate {
+		t.Errorf("\nAn installed application cannot be changed and is always up to date.\ne.Observe(...): got %+v", got)
+	}
+	if cr.Status.AtProvider.Plan != "10GB" {
+		t.Errorf("\nThe plan Civo installed is reported.\ne.Observe(...): want plan 10GB, got %q", cr.Status.AtProvider.Plan)
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civokubernetesnodepool
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/civo/civogo"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoKubernetesNodePool = "managed resource is not a CivoKubernetesNodePool"
+	errGetNodePool               = "cannot get node pool"
+	errCreateNodePool            = "cannot create node pool"
+	errUpdateNodePool            = "cannot update node pool"
+	errReplaceNodePool           = "cannot replace node pool"
+	errDeleteNodePool            = "cannot delete node pool"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoKubernetesNodePool managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoKubernetesNodePoolGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoKubernetesNodePoolGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo pool ID, which is generated when the pool
+		// is created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civokubernetesnodepool", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoKubernetesNodePool{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	nodePool, ok := mg.(*v1alpha1.CivoKubernetesNodePool)
+	if !ok {
+		return nil, errors.New(errNotCivoKubernetesNodePool)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: nodePool.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesNodePool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoKubernetesNodePool)
+	}
+	pool, err := e.civoClient.GetKubernetesNodePool(cr.Spec.ClusterID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetNodePool)
+	}
+	if pool == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateKubernetesNodePoolObservation(cr.Spec.ClusterID, pool)
+
+	// A pool that was replaced is deleted by Update. The annotation is only
+	// removed once the pool is gone, and no further replacement happens until
+	// then so that the old pool is never forgotten.
+	if replaced := cr.GetAnnotations()[v1alpha1.AnnotationKeyReplacedPool]; replaced != "" {
+		old, err := e.civoClient.GetKubernetesNodePool(cr.Spec.ClusterID, replaced)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetNodePool)
+		}
+		if old != nil {
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+		}
+		meta.RemoveAnnotations(cr, v1alpha1.AnnotationKeyReplacedPool)
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true}, nil
+	}
+
+	// Civo cannot change the size or public IP setting of an existing pool. The
+	// pool is reported as missing so that Create replaces it, which persists
+	// the ID of the new pool. A pool being deleted is never replaced.
+	if needsReplacement(cr, pool) && !meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, pool),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesNodePool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoKubernetesNodePool)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// Observe leaves the ID of a pool that has to be replaced in the status.
+	// The new pool is created first so that workloads can be rescheduled onto
+	// it; the old one is deleted by Update once its ID has been recorded.
+	replaced := cr.Status.AtProvider.ID
+
+	id, err := e.civoClient.CreateKubernetesNodePool(cr.Spec.ClusterID, cr.Spec)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodePool)
+	}
+	cr.Status.AtProvider.ID = id
+	meta.SetExternalName(cr, id)
+
+	// A pool that is already waiting to be deleted keeps its place; the pool
+	// being replaced now has gone missing from Civo.
+	if replaced != "" && replaced != id && cr.GetAnnotations()[v1alpha1.AnnotationKeyReplacedPool] == "" {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyReplacedPool: replaced})
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesNodePool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoKubernetesNodePool)
+	}
+	if replaced := cr.GetAnnotations()[v1alpha1.AnnotationKeyReplacedPool]; replaced != "" {
+		if err := e.civoClient.DeleteKubernetesNodePool(cr.Spec.ClusterID, replaced); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errReplaceNodePool)
+		}
+	}
+	pool, err := e.civoClient.GetKubernetesNodePool(cr.Spec.ClusterID, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNodePool)
+	}
+	if pool == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	err = e.civoClient.UpdateKubernetesNodePool(cr.Spec.ClusterID, pool.ID, cr.Spec)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNodePool)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoKubernetesNodePool)
+	if !ok {
+		return errors.New(errNotCivoKubernetesNodePool)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if replaced := cr.GetAnnotations()[v1alpha1.AnnotationKeyReplacedPool]; replaced != "" {
+		if err := e.civoClient.DeleteKubernetesNodePool(cr.Spec.ClusterID, replaced); err != nil {
+			return errors.Wrap(err, errReplaceNodePool)
+		}
+	}
+	err := e.civoClient.DeleteKubernetesNodePool(cr.Spec.ClusterID, meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteNodePool)
+}
+
+// needsReplacement reports whether the pool has to be recreated to match the
+// spec.
+func needsReplacement(cr *v1alpha1.CivoKubernetesNodePool, pool *civogo.KubernetesPool) bool {
+	return cr.Spec.Size != pool.Size || cr.Spec.PublicIPNodePool != pool.PublicIPNodePool
+}
+
+// isUpToDate reports whether the fields that can be changed in place match
+// the spec.
+func isUpToDate(cr *v1alpha1.CivoKubernetesNodePool, pool *civogo.KubernetesPool) bool {
+	if cr.Spec.Count != pool.Count {
+		return false
+	}
+	if (len(cr.Spec.Labels) != 0 || len(pool.Labels) != 0) && !reflect.DeepEqual(cr.Spec.Labels, pool.Labels) {
+		return false
+	}
+	if (len(cr.Spec.Taints) != 0 || len(pool.Taints) != 0) && !reflect.DeepEqual(cr.Spec.Taints, pool.Taints) {
+		return false
+	}
+	return true
+}
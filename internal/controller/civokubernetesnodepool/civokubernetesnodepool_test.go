@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civokubernetesnodepool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+)
+
+// fakePools serves the pools of cluster c-1 and records the requests that
+// change them.
+type fakePools struct {
+	pools   map[string]civogo.KubernetesPool
+	changes []string
+}
+
+func (f *fakePools) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/v2/kubernetes/clusters/c-1/pools"
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	if r.Method != http.MethodGet {
+		f.changes = append(f.changes, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	}
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		cfg := civogo.KubernetesClusterPoolUpdateConfig{}
+		_ = json.NewDecoder(r.Body).Decode(&cfg)
+		f.pools[cfg.ID] = civogo.KubernetesPool{ID: cfg.ID, Count: cfg.Count, Size: cfg.Size}
+		_, _ = w.Write([]byte(`{"result": "success"}`))
+		return
+	case r.Method == http.MethodDelete:
+		delete(f.pools, id)
+		_, _ = w.Write([]byte(`{"result": "success"}`))
+		return
+	}
+	pool, ok := f.pools[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": "database_cluster_pool_not_found", "reason": "pool not found"}`))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(pool)
+}
+
+func TestReplacePoolWithNewSize(t *testing.T) {
+	fake := &fakePools{pools: map[string]civogo.KubernetesPool{
+		"pool-small": {ID: "pool-small", Count: 2, Size: "g4s.kube.small"},
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &external{civoClient: civoClient}
+	ctx := context.Background()
+
+	cr := &v1alpha1.CivoKubernetesNodePool{Spec: v1alpha1.CivoKubernetesNodePoolSpec{
+		ClusterID: "c-1",
+		Size:      "g4s.kube.large",
+		Count:     2,
+	}}
+	meta.SetExternalName(cr, "pool-small")
+
+	observe := func(reason string, want managed.ExternalObservation) {
+		t.Helper()
+		got, err := e.Observe(ctx, cr)
+		if err != nil {
+			t.Fatalf("\n%s\ne.Observe(...): unexpected error: %v", reason, err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", reason, diff)
+		}
+	}
+
+	observe("A pool of another size is reported as missing so that Create replaces it.",
+		managed.ExternalObservation{ResourceExists: false})
+
+	if _, err := e.Create(ctx, cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if got := cr.GetAnnotations()[v1alpha1.AnnotationKeyReplacedPool]; got != "pool-small" {
+		t.Errorf("\nCreate records the replaced pool in an annotation, which survives the create.\ne.Create(...): want annotation pool-small, got %q", got)
+	}
+	newPool := meta.GetExternalName(cr)
+	if diff := cmp.Diff([]string{"POST /v2/kubernetes/clusters/c-1/pools"}, fake.changes); diff != "" {
+		t.Errorf("\nThe old pool keeps running until the new pool has been recorded.\ne.Create(...): -want, +got:\n%s", diff)
+	}
+
+	observe("While the replaced pool exists the resource needs an update to delete it.",
+		managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false})
+
+	fake.changes = nil
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+	want := []string{
+		"DELETE /v2/kubernetes/clusters/c-1/pools/pool-small",
+		"PUT /v2/kubernetes/clusters/c-1/pools/" + newPool,
+	}
+	if diff := cmp.Diff(want, fake.changes); diff != "" {
+		t.Errorf("\nUpdate deletes the replaced pool.\ne.Update(...): -want, +got:\n%s", diff)
+	}
+
+	observe("Once the replaced pool is gone its annotation is removed and persisted.",
+		managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true})
+	if _, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyReplacedPool]; ok {
+		t.Errorf("\nThe annotation of a deleted pool is removed.\ne.Observe(...): annotation still set")
+	}
+
+	observe("The new pool matches the spec.",
+		managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true})
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civoloadbalancer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/loadbalancer/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoLoadBalancer  = "managed resource is not a CivoLoadBalancer"
+	errGetLoadBalancer      = "cannot get load balancer"
+	errCreateLoadBalancer   = "cannot create load balancer"
+	errUpdateLoadBalancer   = "cannot update load balancer"
+	errDeleteLoadBalancer   = "cannot delete load balancer"
+	loadBalancerStateActive = "available"
+	// defaultBackendProtocol is the protocol Civo uses for a backend that
+	// does not set one.
+	defaultBackendProtocol = "tcp"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoLoadBalancer managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoLoadBalancerGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoLoadBalancerGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo load balancer ID, which is only
+		// known once the load balancer has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civoloadbalancer", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoLoadBalancer{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	lb, ok := mg.(*v1alpha1.CivoLoadBalancer)
+	if !ok {
+		return nil, errors.New(errNotCivoLoadBalancer)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: lb.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoLoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoLoadBalancer)
+	}
+	civoLoadBalancer, err := e.civoClient.GetLoadBalancer(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetLoadBalancer)
+	}
+	if civoLoadBalancer == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateLoadBalancerObservation(civoLoadBalancer)
+
+	if !strings.EqualFold(civoLoadBalancer.State, loadBalancerStateActive) {
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, civoLoadBalancer),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(civoLoadBalancer.PublicIP),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoLoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoLoadBalancer)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	lb, err := e.civoClient.CreateLoadBalancer(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateLoadBalancer)
+	}
+	cr.Status.AtProvider.ID = lb.ID
+	meta.SetExternalName(cr, lb.ID)
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(lb.PublicIP),
+	}}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoLoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoLoadBalancer)
+	}
+
+	err := e.civoClient.UpdateLoadBalancer(meta.GetExternalName(cr), cr)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLoadBalancer)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoLoadBalancer)
+	if !ok {
+		return errors.New(errNotCivoLoadBalancer)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteLoadBalancer(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteLoadBalancer)
+}
+
+func isUpToDate(cr *v1alpha1.CivoLoadBalancer, lb *civogo.LoadBalancer) bool {
+	if cr.Spec.Name != lb.Name {
+		return false
+	}
+	if cr.Spec.Algorithm != "" && cr.Spec.Algorithm != lb.Algorithm {
+		return false
+	}
+	if cr.Spec.FirewallID != "" && cr.Spec.FirewallID != lb.FirewallID {
+		return false
+	}
+	return areBackendsEqual(cr.Spec.Backends, lb.Backends)
+}
+
+// areBackendsEqual compares the desired and remote backends regardless of
+// their order.
+func areBackendsEqual(desired []v1alpha1.LoadBalancerBackend, remote []civogo.LoadBalancerBackend) bool {
+	if len(desired) != len(remote) {
+		return false
+	}
+	desiredKeys := make([]string, len(desired))
+	for i, backend := range civocli.ConvertLoadBalancerBackends(desired) {
+		desiredKeys[i] = backendKey(backend.IP, backend.Protocol, backend.SourcePort, backend.TargetPort, backend.HealthCheckPort)
+	}
+	remoteKeys := make([]string, len(remote))
+	for i, backend := range remote {
+		remoteKeys[i] = backendKey(backend.IP, backend.Protocol, backend.SourcePort, backend.TargetPort, backend.HealthCheckPort)
+	}
+	sort.Strings(desiredKeys)
+	sort.Strings(remoteKeys)
+	for i := range desiredKeys {
+		if desiredKeys[i] != remoteKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func backendKey(ip, protocol string, sourcePort, targetPort, healthCheckPort int32) string {
+	// Civo reports the target port as health check port when none was requested.
+	if healthCheckPort == 0 {
+		healthCheckPort = targetPort
+	}
+	// Civo reports TCP as protocol when none was requested.
+	if protocol == "" {
+		protocol = defaultBackendProtocol
+	}
+	return fmt.Sprintf("%s|%s|%d|%d|%d", ip, strings.ToLower(protocol), sourcePort, targetPort, healthCheckPort)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civoloadbalancer
+
+import (
+	"testing"
+
+	"github.com/civo/civogo"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/loadbalancer/v1alpha1"
+)
+
+func TestAreBackendsEqual(t *testing.T) {
+	web := v1alpha1.LoadBalancerBackend{IP: "10.0.0.1", Protocol: "TCP", SourcePort: 80, TargetPort: 8080, HealthCheckPort: 8081}
+	api := v1alpha1.LoadBalancerBackend{IP: "10.0.0.2", Protocol: "http", SourcePort: 443, TargetPort: 8443}
+	remoteWeb := civogo.LoadBalancerBackend{IP: "10.0.0.1", Protocol: "tcp", SourcePort: 80, TargetPort: 8080, HealthCheckPort: 8081}
+	remoteAPI := civogo.LoadBalancerBackend{IP: "10.0.0.2", Protocol: "http", SourcePort: 443, TargetPort: 8443, HealthCheckPort: 8443}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1alpha1.LoadBalancerBackend
+		remote  []civogo.LoadBalancerBackend
+		want    bool
+	}{
+		"ReorderedBackends": {
+			reason:  "Backends are matched by content, so their order and the case of the protocol do not matter.",
+			desired: []v1alpha1.LoadBalancerBackend{api, web},
+			remote:  []civogo.LoadBalancerBackend{remoteWeb, remoteAPI},
+			want:    true,
+		},
+		"HealthCheckOnTargetPort": {
+			reason:  "Civo health checks the target port when no health check port is set.",
+			desired: []v1alpha1.LoadBalancerBackend{api},
+			remote:  []civogo.LoadBalancerBackend{{IP: "10.0.0.2", Protocol: "http", SourcePort: 443, TargetPort: 8443}},
+			want:    true,
+		},
+		"SSHWithoutProtocol": {
+			reason:  "A backend without a protocol is a TCP backend, as Civo reports it.",
+			desired: []v1alpha1.LoadBalancerBackend{{IP: "10.0.0.3", SourcePort: 22, TargetPort: 22}},
+			remote:  []civogo.LoadBalancerBackend{{IP: "10.0.0.3", Protocol: "TCP", SourcePort: 22, TargetPort: 22, HealthCheckPort: 22}},
+			want:    true,
+		},
+		"HTTPBackendWithoutProtocol": {
+			reason:  "A backend without a protocol must not match an HTTP backend on Civo.",
+			desired: []v1alpha1.LoadBalancerBackend{{IP: "10.0.0.3", SourcePort: 80, TargetPort: 80}},
+			remote:  []civogo.LoadBalancerBackend{{IP: "10.0.0.3", Protocol: "http", SourcePort: 80, TargetPort: 80}},
+			want:    false,
+		},
+		"APINotYetAdded": {
+			reason:  "A backend of the spec that Civo does not have needs an update.",
+			desired: []v1alpha1.LoadBalancerBackend{web, api},
+			remote:  []civogo.LoadBalancerBackend{remoteWeb},
+			want:    false,
+		},
+		"APIRemovedFromSpec": {
+			reason:  "A backend that is no longer in the spec needs an update.",
+			desired: []v1alpha1.LoadBalancerBackend{web},
+			remote:  []civogo.LoadBalancerBackend{remoteWeb, remoteAPI},
+			want:    false,
+		},
+		"TargetPortMoved": {
+			reason:  "A changed target port needs an update.",
+			desired: []v1alpha1.LoadBalancerBackend{web},
+			remote:  []civogo.LoadBalancerBackend{{IP: "10.0.0.1", Protocol: "tcp", SourcePort: 80, TargetPort: 9090, HealthCheckPort: 8081}},
+			want:    false,
+		},
+		"DuplicateInSpec": {
+			reason:  "Comparing the number of backends is not enough when the spec lists one twice.",
+			desired: []v1alpha1.LoadBalancerBackend{web, web},
+			remote:  []civogo.LoadBalancerBackend{remoteWeb, remoteAPI},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := areBackendsEqual(tc.desired, tc.remote); got != tc.want {
+				t.Errorf("\n%s\nareBackendsEqual(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
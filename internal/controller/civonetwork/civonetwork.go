@@ -0,0 +1,199 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civonetwork
+
+import (
+	"context"
+	"strings"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoNetwork = "managed resource is not a CivoNetwork"
+	errGetNetwork     = "cannot get network"
+	errCreateNetwork  = "cannot create network"
+	errUpdateNetwork  = "cannot update network"
+	errDeleteNetwork  = "cannot delete network"
+
+	networkStateBuilding = "building"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoNetwork managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoNetworkGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoNetworkGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo network ID, which is only known
+		// once the network has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civonetwork", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoNetwork{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	network, ok := mg.(*v1alpha1.CivoNetwork)
+	if !ok {
+		return nil, errors.New(errNotCivoNetwork)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: network.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoNetwork)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoNetwork)
+	}
+	civoNetwork, err := e.civoClient.GetNetwork(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetNetwork)
+	}
+	if civoNetwork == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateNetworkObservation(civoNetwork)
+
+	if strings.EqualFold(civoNetwork.Status, networkStateBuilding) {
+		cr.SetConditions(xpv1.Creating())
+	} else {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, civoNetwork),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoNetwork)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoNetwork)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	network, err := e.civoClient.CreateNetwork(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNetwork)
+	}
+	cr.Status.AtProvider.ID = network.ID
+	meta.SetExternalName(cr, network.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoNetwork)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoNetwork)
+	}
+
+	err := e.civoClient.UpdateNetwork(meta.GetExternalName(cr), cr)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNetwork)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoNetwork)
+	if !ok {
+		return errors.New(errNotCivoNetwork)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteNetwork(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteNetwork)
+}
+
+// isUpToDate only compares the fields Civo allows to change after creation.
+func isUpToDate(cr *v1alpha1.CivoNetwork, network *civogo.Network) bool {
+	if cr.Spec.Label != network.Label {
+		return false
+	}
+	// Civo fills in its own nameservers when none are requested.
+	if len(cr.Spec.NameserversV4) == 0 {
+		return true
+	}
+	if len(cr.Spec.NameserversV4) != len(network.NameserversV4) {
+		return false
+	}
+	for i := range cr.Spec.NameserversV4 {
+		if cr.Spec.NameserversV4[i] != network.NameserversV4[i] {
+			return false
+		}
+	}
+	return true
+}
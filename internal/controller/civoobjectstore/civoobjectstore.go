@@ -0,0 +1,204 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civoobjectstore
+
+import (
+	"context"
+	"strings"
+
+	"github.com/civo/civogo"
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/objectstore/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoObjectStore = "managed resource is not a CivoObjectStore"
+	errGetObjectStore     = "cannot get object store"
+	errGetCredential      = "cannot get object store credential"
+	errCreateObjectStore  = "cannot create object store"
+	errUpdateObjectStore  = "cannot update object store"
+	errDeleteObjectStore  = "cannot delete object store"
+
+	objectStoreStateReady = "ready"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoObjectStore managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoObjectStoreGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoObjectStoreGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo object store ID, which is only known once
+		// the store has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civoobjectstore", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoObjectStore{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	store, ok := mg.(*v1alpha1.CivoObjectStore)
+	if !ok {
+		return nil, errors.New(errNotCivoObjectStore)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: store.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoObjectStore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoObjectStore)
+	}
+	civoObjectStore, err := e.civoClient.GetObjectStore(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetObjectStore)
+	}
+	if civoObjectStore == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateObjectStoreObservation(civoObjectStore)
+
+	if !strings.EqualFold(civoObjectStore.Status, objectStoreStateReady) {
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	credential, err := e.civoClient.GetObjectStoreCredential(civoObjectStore.OwnerInfo.CredentialID)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true}, errors.Wrap(err, errGetCredential)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  int64(civoObjectStore.MaxSize) == cr.Spec.MaxSizeGB,
+		ConnectionDetails: connectionDetails(civoObjectStore, credential),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoObjectStore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoObjectStore)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	store, err := e.civoClient.CreateObjectStore(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateObjectStore)
+	}
+	cr.Status.AtProvider.ID = store.ID
+	meta.SetExternalName(cr, store.ID)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoObjectStore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoObjectStore)
+	}
+
+	err := e.civoClient.UpdateObjectStore(meta.GetExternalName(cr), cr)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateObjectStore)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoObjectStore)
+	if !ok {
+		return errors.New(errNotCivoObjectStore)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteObjectStore(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteObjectStore)
+}
+
+// connectionDetails returns everything an S3 client needs to use the bucket.
+// The keys are only published once the owning credential can be read.
+func connectionDetails(store *civogo.ObjectStore, credential *civogo.ObjectStoreCredential) managed.ConnectionDetails {
+	details := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(store.BucketURL),
+		v1alpha1.ConnectionKeyBucket:              []byte(store.Name),
+	}
+	if credential != nil {
+		details[v1alpha1.ConnectionKeyAccessKeyID] = []byte(credential.AccessKeyID)
+		details[v1alpha1.ConnectionKeySecretAccessKey] = []byte(credential.SecretAccessKeyID)
+	}
+	return details
+}
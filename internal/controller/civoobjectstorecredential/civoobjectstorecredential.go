@@ -0,0 +1,218 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civoobjectstorecredential
+
+import (
+	"context"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/objectstore/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoObjectStoreCredential = "managed resource is not a CivoObjectStoreCredential"
+	errGetCredential                = "cannot get object store credential"
+	errCreateCredential             = "cannot create object store credential"
+	errUpdateCredential             = "cannot update object store credential"
+	errDeleteCredential             = "cannot delete object store credential"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoObjectStoreCredential managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoObjectStoreCredentialGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoObjectStoreCredentialGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo credential ID, which is only known once
+		// the credential has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civoobjectstorecredential", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoObjectStoreCredential{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	credential, ok := mg.(*v1alpha1.CivoObjectStoreCredential)
+	if !ok {
+		return nil, errors.New(errNotCivoObjectStoreCredential)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: credential.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoObjectStoreCredential)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoObjectStoreCredential)
+	}
+	civoCredential, err := e.civoClient.GetObjectStoreCredential(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetCredential)
+	}
+	if civoCredential == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rotated := lastRotation(cr)
+	cr.Status.AtProvider = civocli.GenerateObjectStoreCredentialObservation(civoCredential)
+	cr.Status.AtProvider.LastRotation = rotated
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  !rotationRequested(cr) && isMaxSizeUpToDate(cr, civoCredential),
+		ConnectionDetails: connectionDetails(civoCredential),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoObjectStoreCredential)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoObjectStoreCredential)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	credential, err := e.civoClient.CreateObjectStoreCredential(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCredential)
+	}
+	cr.Status.AtProvider.ID = credential.ID
+	// A new credential already has a fresh secret key, so the current value
+	// of the rotate annotation counts as handled. It is kept in an annotation
+	// as only annotations set by Create are persisted.
+	if rotation := cr.GetAnnotations()[v1alpha1.AnnotationKeyRotate]; rotation != "" {
+		meta.AddAnnotations(cr, map[string]string{v1alpha1.AnnotationKeyCreatedRotation: rotation})
+	}
+	meta.SetExternalName(cr, credential.ID)
+	return managed.ExternalCreation{ConnectionDetails: connectionDetails(credential)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoObjectStoreCredential)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoObjectStoreCredential)
+	}
+
+	rotate := rotationRequested(cr)
+	credential, err := e.civoClient.UpdateObjectStoreCredential(meta.GetExternalName(cr), cr, rotate)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCredential)
+	}
+	if rotate {
+		cr.Status.AtProvider.LastRotation = cr.GetAnnotations()[v1alpha1.AnnotationKeyRotate]
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: connectionDetails(credential)}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoObjectStoreCredential)
+	if !ok {
+		return errors.New(errNotCivoObjectStoreCredential)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteObjectStoreCredential(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteCredential)
+}
+
+// rotationRequested reports whether the rotate annotation was set to a new
+// value since the secret key was last rotated. Removing the annotation does
+// not rotate the secret key.
+func rotationRequested(cr *v1alpha1.CivoObjectStoreCredential) bool {
+	rotation := cr.GetAnnotations()[v1alpha1.AnnotationKeyRotate]
+	return rotation != "" && rotation != lastRotation(cr)
+}
+
+// lastRotation returns the value of the rotate annotation the secret key was
+// last rotated for.
+func lastRotation(cr *v1alpha1.CivoObjectStoreCredential) string {
+	if cr.Status.AtProvider.LastRotation != "" {
+		return cr.Status.AtProvider.LastRotation
+	}
+	return cr.GetAnnotations()[v1alpha1.AnnotationKeyCreatedRotation]
+}
+
+func isMaxSizeUpToDate(cr *v1alpha1.CivoObjectStoreCredential, credential *civogo.ObjectStoreCredential) bool {
+	return cr.Spec.MaxSizeGB == nil || *cr.Spec.MaxSizeGB == credential.MaxSizeGB
+}
+
+func connectionDetails(credential *civogo.ObjectStoreCredential) managed.ConnectionDetails {
+	if credential == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionKeyAccessKeyID:     []byte(credential.AccessKeyID),
+		v1alpha1.ConnectionKeySecretAccessKey: []byte(credential.SecretAccessKeyID),
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civoobjectstorecredential
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/objectstore/v1alpha1"
+)
+
+func TestRotationRequested(t *testing.T) {
+	credential := func(rotate, created, last string) *v1alpha1.CivoObjectStoreCredential {
+		annotations := map[string]string{}
+		if rotate != "" {
+			annotations[v1alpha1.AnnotationKeyRotate] = rotate
+		}
+		if created != "" {
+			annotations[v1alpha1.AnnotationKeyCreatedRotation] = created
+		}
+		cr := &v1alpha1.CivoObjectStoreCredential{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+		cr.Status.AtProvider.LastRotation = last
+		return cr
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.CivoObjectStoreCredential
+		want   bool
+	}{
+		"NeverAnnotated": {
+			reason: "A credential without the rotate annotation is never rotated.",
+			cr:     credential("", "", ""),
+			want:   false,
+		},
+		"CreatedWithAnnotation": {
+			reason: "A credential created with the rotate annotation already has a fresh key.",
+			cr:     credential("1", "1", ""),
+			want:   false,
+		},
+		"ChangedAfterCreate": {
+			reason: "Changing the annotation of a credential that was never rotated rotates it.",
+			cr:     credential("2", "1", ""),
+			want:   true,
+		},
+		"AddedAfterCreate": {
+			reason: "Adding the annotation to a credential created without it rotates it.",
+			cr:     credential("1", "", ""),
+			want:   true,
+		},
+		"AlreadyRotated": {
+			reason: "A value the key was already rotated for does not rotate it again.",
+			cr:     credential("2", "1", "2"),
+			want:   false,
+		},
+		"RotatedValueWinsOverCreatedValue": {
+			reason: "Going back to the value the credential was created with is a new rotation.",
+			cr:     credential("1", "1", "2"),
+			want:   true,
+		},
+		"AnnotationRemoved": {
+			reason: "Removing the annotation does not rotate the key.",
+			cr:     credential("", "1", "2"),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := rotationRequested(tc.cr); got != tc.want {
+				t.Errorf("%s\nrotationRequested(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
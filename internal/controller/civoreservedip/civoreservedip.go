@@ -0,0 +1,234 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civoreservedip
+
+import (
+	"context"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/ip/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoReservedIP   = "managed resource is not a CivoReservedIP"
+	errGetReservedIP       = "cannot get reserved IP"
+	errCreateReservedIP    = "cannot create reserved IP"
+	errUpdateReservedIP    = "cannot update reserved IP"
+	errAssignReservedIP    = "cannot assign reserved IP"
+	errUnassignReservedIP  = "cannot unassign reserved IP"
+	errDeleteReservedIP    = "cannot delete reserved IP"
+	errMultipleAssignments = "only one of instanceId and loadBalancerId can be set"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoReservedIP managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoReservedIPGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoReservedIPGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo reserved IP ID, which is only known once
+		// the IP has been allocated.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civoreservedip", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoReservedIP{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ip, ok := mg.(*v1alpha1.CivoReservedIP)
+	if !ok {
+		return nil, errors.New(errNotCivoReservedIP)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: ip.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoReservedIP)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoReservedIP)
+	}
+	civoIP, err := e.civoClient.GetReservedIP(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetReservedIP)
+	}
+	if civoIP == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateReservedIPObservation(civoIP)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, civoIP),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoReservedIP)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoReservedIP)
+	}
+	if cr.Spec.InstanceID != "" && cr.Spec.LoadBalancerID != "" {
+		return managed.ExternalCreation{}, errors.New(errMultipleAssignments)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	ip, err := e.civoClient.CreateReservedIP(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateReservedIP)
+	}
+	cr.Status.AtProvider.ID = ip.ID
+	meta.SetExternalName(cr, ip.ID)
+
+	// The assignment is left to the next reconcile when it fails, the IP
+	// itself exists and must not be allocated a second time.
+	if resourceID, resourceType := desiredAssignee(cr); resourceID != "" {
+		if err := e.civoClient.AssignReservedIP(ip.ID, resourceID, resourceType); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errAssignReservedIP)
+		}
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoReservedIP)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoReservedIP)
+	}
+	if cr.Spec.InstanceID != "" && cr.Spec.LoadBalancerID != "" {
+		return managed.ExternalUpdate{}, errors.New(errMultipleAssignments)
+	}
+
+	id := meta.GetExternalName(cr)
+	civoIP, err := e.civoClient.GetReservedIP(id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetReservedIP)
+	}
+	if civoIP == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if cr.Spec.Name != "" && cr.Spec.Name != civoIP.Name {
+		if err := e.civoClient.RenameReservedIP(id, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateReservedIP)
+		}
+	}
+
+	resourceID, resourceType := desiredAssignee(cr)
+	switch {
+	case resourceID == "" && civoIP.AssignedTo.ID != "":
+		if err := e.civoClient.UnassignReservedIP(id); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUnassignReservedIP)
+		}
+	case resourceID != "" && resourceID != civoIP.AssignedTo.ID:
+		if err := e.civoClient.AssignReservedIP(id, resourceID, resourceType); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAssignReservedIP)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoReservedIP)
+	if !ok {
+		return errors.New(errNotCivoReservedIP)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteReservedIP(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteReservedIP)
+}
+
+// desiredAssignee returns the ID and type of the resource the reserved IP
+// should be assigned to, or an empty ID when it should stay unassigned.
+func desiredAssignee(cr *v1alpha1.CivoReservedIP) (string, string) {
+	switch {
+	case cr.Spec.InstanceID != "":
+		return cr.Spec.InstanceID, civocli.ReservedIPTypeInstance
+	case cr.Spec.LoadBalancerID != "":
+		return cr.Spec.LoadBalancerID, civocli.ReservedIPTypeLoadBalancer
+	}
+	return "", ""
+}
+
+func isUpToDate(cr *v1alpha1.CivoReservedIP, ip *civogo.IP) bool {
+	// Civo names the IP after its address when no name is requested.
+	if cr.Spec.Name != "" && cr.Spec.Name != ip.Name {
+		return false
+	}
+	resourceID, _ := desiredAssignee(cr)
+	return resourceID == ip.AssignedTo.ID
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civosshkey
+
+import (
+	"context"
+
+	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/sshkey/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCivoSSHKey     = "managed resource is not a CivoSSHKey"
+	errGetSSHKey         = "cannot get SSH key"
+	errCreateSSHKey      = "cannot create SSH key"
+	errUpdateSSHKey      = "cannot update SSH key"
+	errDeleteSSHKey      = "cannot delete SSH key"
+	errGetPublicKey      = "cannot get SSH public key secret %s"
+	errPublicKeyRequired = "one of publicKey or publicKeySecretRef must be set"
+)
+
+// typeKeyInSync is the condition type that reports whether the public key on
+// Civo is the one of the spec. Civo cannot change the public key of an SSH
+// key, so a changed key in the referenced Secret is only reported.
+const (
+	typeKeyInSync    xpv1.ConditionType   = "KeyInSync"
+	reasonKeyInSync  xpv1.ConditionReason = "InSync"
+	reasonKeyChanged xpv1.ConditionReason = "KeyChanged"
+
+	msgKeyChanged = "the public key differs from the key uploaded to Civo, which cannot be changed; create a new CivoSSHKey to use the new key"
+)
+
+type connecter struct {
+	client client.Client
+}
+
+type external struct {
+	kube       client.Client
+	civoClient *civocli.CivoClient
+}
+
+// Setup adds a controller that reconciles CivoSSHKey managed resources.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.BucketRateLimiter) error {
+	name := providerconfig.ControllerName(v1alpha1.CivoSSHKeyGroupKind)
+
+	o := controller.Options{
+		RateLimiter: &rl,
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CivoSSHKeyGroupVersionKind),
+		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		// The external name is the Civo SSH key ID, which is only known once the
+		// key has been created.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("civosshkey", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o).
+		For(&v1alpha1.CivoSSHKey{}).
+		Complete(r)
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	sshKey, ok := mg.(*v1alpha1.CivoSSHKey)
+	if !ok {
+		return nil, errors.New(errNotCivoSSHKey)
+	}
+
+	providerConfig := &v1alpha1provider.ProviderConfig{}
+
+	err := c.client.Get(ctx, types.NamespacedName{
+		Name: sshKey.Spec.ProviderConfigReference.Name}, providerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: providerConfig.Spec.Credentials.SecretRef.Name,
+		Namespace: providerConfig.Spec.Credentials.SecretRef.Namespace}, s); err != nil {
+		return nil, errors.New("could not find secret")
+	}
+
+	civoClient, err := civocli.NewCivoClient(string(s.Data["credentials"]), providerConfig.Spec.Region)
+
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:       c.client,
+		civoClient: civoClient,
+	}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CivoSSHKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCivoSSHKey)
+	}
+	civoSSHKey, err := e.civoClient.GetSSHKey(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(err, errGetSSHKey)
+	}
+	if civoSSHKey == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = civocli.GenerateSSHKeyObservation(civoSSHKey)
+	cr.SetConditions(xpv1.Available())
+
+	publicKey, err := e.publicKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(keyInSync(publicKey, civoSSHKey.Fingerprint))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: civoSSHKey.Name == cr.Spec.Name,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CivoSSHKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCivoSSHKey)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	publicKey, err := e.publicKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	id, err := e.civoClient.CreateSSHKey(cr.Spec.Name, publicKey)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSSHKey)
+	}
+	cr.Status.AtProvider.ID = id
+	meta.SetExternalName(cr, id)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CivoSSHKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCivoSSHKey)
+	}
+
+	err := e.civoClient.RenameSSHKey(meta.GetExternalName(cr), cr.Spec.Name)
+
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSSHKey)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CivoSSHKey)
+	if !ok {
+		return errors.New(errNotCivoSSHKey)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	err := e.civoClient.DeleteSSHKey(meta.GetExternalName(cr))
+	return errors.Wrap(err, errDeleteSSHKey)
+}
+
+// publicKey returns the SSH public key from the spec or from the referenced
+// Secret.
+func (e *external) publicKey(ctx context.Context, cr *v1alpha1.CivoSSHKey) (string, error) {
+	if cr.Spec.PublicKey != "" {
+		return cr.Spec.PublicKey, nil
+	}
+	ref := cr.Spec.PublicKeySecretRef
+	if ref == nil {
+		return "", errors.New(errPublicKeyRequired)
+	}
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	if err := e.kube.Get(ctx, n, s); err != nil {
+		return "", errors.Wrapf(err, errGetPublicKey, n)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// keyInSync returns the KeyInSync condition for the public key of the spec and
+// the fingerprint of the key on Civo. Civo may not report a fingerprint, in
+// which case the key is assumed to be unchanged.
+func keyInSync(publicKey, fingerprint string) xpv1.Condition {
+	if fingerprint != "" && !civocli.SSHKeyMatchesFingerprint(publicKey, fingerprint) {
+		return xpv1.Condition{
+			Type:               typeKeyInSync,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reasonKeyChanged,
+			Message:            msgKeyChanged,
+		}
+	}
+	return xpv1.Condition{
+		Type:               typeKeyInSync,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonKeyInSync,
+	}
+}
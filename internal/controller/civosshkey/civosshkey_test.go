@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package civosshkey
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/sshkey/v1alpha1"
+	"github.com/crossplane-contrib/provider-civo/pkg/civocli"
+)
+
+const (
+	uploadedKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDjG8Q1P8r4/fqSCjB/djHT++8dWkqN27buW3vqoD6+I deploy@ci"
+	rotatedKey  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIH7x0bXxJ2Q0s3kq6Q8rU3mH0eY4bKf1m6Zr9dV2cN1a deploy@ci"
+)
+
+func TestObserveReportsChangedKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id": "key-sha256", "name": "deploy", "fingerprint": "SHA256:h3uO2GqyuTTA1IOb/43qRCe0Il34vCOwHDM9+KTBV+8"},
+			{"id": "key-unknown", "name": "deploy", "fingerprint": ""}
+		]`))
+	}))
+	defer server.Close()
+	civoClient, err := civocli.NewCivoClientWithURL("token", server.URL, "LON1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		reason     string
+		id         string
+		secretKey  string
+		wantStatus corev1.ConditionStatus
+		wantReason xpv1.ConditionReason
+	}{
+		"SecretUnchanged": {
+			reason:     "The key in the Secret is the one uploaded to Civo.",
+			id:         "key-sha256",
+			secretKey:  uploadedKey + "\n",
+			wantStatus: corev1.ConditionTrue,
+			wantReason: reasonKeyInSync,
+		},
+		"SecretRotated": {
+			reason:     "A key rotated in the Secret cannot be changed on Civo and is reported.",
+			id:         "key-sha256",
+			secretKey:  rotatedKey,
+			wantStatus: corev1.ConditionFalse,
+			wantReason: reasonKeyChanged,
+		},
+		"CivoOmitsFingerprint": {
+			reason:     "Without a fingerprint from Civo the key cannot be compared and is assumed unchanged.",
+			id:         "key-unknown",
+			secretKey:  rotatedKey,
+			wantStatus: corev1.ConditionTrue,
+			wantReason: reasonKeyInSync,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				if key.Namespace != "ci" || key.Name != "deploy-key" {
+					t.Errorf("\n%s\ne.Observe(...): unexpected Secret %s", tc.reason, key)
+				}
+				obj.(*corev1.Secret).Data = map[string][]byte{"id_ed25519.pub": []byte(tc.secretKey)}
+				return nil
+			}}
+			cr := &v1alpha1.CivoSSHKey{Spec: v1alpha1.CivoSSHKeySpec{
+				Name: "deploy",
+				PublicKeySecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: "ci", Name: "deploy-key"},
+					Key:             "id_ed25519.pub",
+				},
+			}}
+			meta.SetExternalName(cr, tc.id)
+			e := &external{kube: kube, civoClient: civoClient}
+
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): unexpected error: %v", tc.reason, err)
+			}
+			if !got.ResourceExists || !got.ResourceUpToDate {
+				t.Errorf("\n%s\ne.Observe(...): a changed key must not trigger an update, got %+v", tc.reason, got)
+			}
+			c := cr.GetCondition(typeKeyInSync)
+			if c.Status != tc.wantStatus || c.Reason != tc.wantReason {
+				t.Errorf("\n%s\ne.Observe(...): want KeyInSync %s/%s, got %s/%s", tc.reason, tc.wantStatus, tc.wantReason, c.Status, c.Reason)
+			}
+		})
+	}
+}
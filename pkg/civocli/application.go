@@ -0,0 +1,115 @@
+package civocli
+
+import (
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+// GenerateKubernetesApplicationObservation creates the CivoKubernetesApplicationObservation from application infos
+func GenerateKubernetesApplicationObservation(clusterID string, app *civogo.KubernetesInstalledApplication) v1alpha1.CivoKubernetesApplicationObservation {
+	return v1alpha1.CivoKubernetesApplicationObservation{
+		ClusterID: clusterID,
+		Name:      app.Name,
+		Plan:      app.Plan,
+		Version:   app.Version,
+		Installed: app.Installed,
+	}
+}
+
+// GetK3sClusterApplication gets a marketplace application installed on a K3s
+// cluster on Civo.
+func (c *CivoClient) GetK3sClusterApplication(clusterID, name string) (*civogo.KubernetesInstalledApplication, error) {
+	if clusterID == "" {
+		return nil, nil
+	}
+	cluster, err := c.civoGoClient.GetKubernetesCluster(clusterID)
+	if err != nil {
+		// The application is gone with its cluster as well.
+		if isNotFound(err, civogo.DatabaseKubernetesClusterNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range cluster.InstalledApplications {
+		app := &cluster.InstalledApplications[i]
+		if strings.EqualFold(app.Name, name) || strings.EqualFold(app.Application, name) {
+			return app, nil
+		}
+	}
+	return nil, nil
+}
+
+// ApplicationPlans returns the applications with the marketplace plan whose
+// configuration has the values given for them, as name:plan. Civo only
+// configures an application through its plans. Applications without
+// configuration values are returned unchanged.
+func (c *CivoClient) ApplicationPlans(applications []string, configuration map[string]map[string]string) ([]string, error) {
+	configured := false
+	for _, app := range applications {
+		name, _, _ := strings.Cut(app, ":")
+		if len(configuration[name]) > 0 {
+			configured = true
+		}
+	}
+	if !configured {
+		return applications, nil
+	}
+	marketplace, err := c.civoGoClient.ListKubernetesMarketplaceApplications()
+	if err != nil {
+		return nil, err
+	}
+	return ConfiguredApplications(applications, configuration, marketplace)
+}
+
+// ConfiguredApplications picks the plan of every application that has
+// configuration values from the marketplace applications.
+func ConfiguredApplications(applications []string, configuration map[string]map[string]string, marketplace []civogo.KubernetesMarketplaceApplication) ([]string, error) {
+	configured := make([]string, 0, len(applications))
+	for _, app := range applications {
+		name, plan, hasPlan := strings.Cut(app, ":")
+		values := configuration[name]
+		if len(values) == 0 {
+			configured = append(configured, app)
+			continue
+		}
+		if hasPlan {
+			return nil, errors.Errorf("application %s has both a plan (%s) and configuration values", name, plan)
+		}
+		plan, err := matchingPlan(name, values, marketplace)
+		if err != nil {
+			return nil, err
+		}
+		configured = append(configured, name+":"+plan)
+	}
+	return configured, nil
+}
+
+// matchingPlan returns the label of the first plan of an application whose
+// configuration contains all the given values.
+func matchingPlan(name string, values map[string]string, marketplace []civogo.KubernetesMarketplaceApplication) (string, error) {
+	for _, app := range marketplace {
+		if !strings.EqualFold(app.Name, name) {
+			continue
+		}
+		for _, plan := range app.Plans {
+			if planHasValues(plan, values) {
+				return plan.Label, nil
+			}
+		}
+		return "", errors.Errorf("no plan of application %s matches its configuration values", name)
+	}
+	return "", errors.Errorf("application %s is not in the Civo marketplace", name)
+}
+
+func planHasValues(plan civogo.KubernetesMarketplacePlan, values map[string]string) bool {
+	for key, value := range values {
+		config, ok := plan.Configuration[key]
+		if !ok || config.Value != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package civocli
+
+import (
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfiguredApplications(t *testing.T) {
+	marketplace := []civogo.KubernetesMarketplaceApplication{
+		{
+			Name: "MariaDB",
+			Plans: []civogo.KubernetesMarketplacePlan{
+				{Label: "5GB", Configuration: map[string]civogo.KubernetesPlanConfiguration{"VOLUME_SIZE": {Value: "5Gi"}}},
+				{Label: "10GB", Configuration: map[string]civogo.KubernetesPlanConfiguration{"VOLUME_SIZE": {Value: "10Gi"}}},
+			},
+		},
+		{Name: "cert-manager"},
+	}
+
+	cases := map[string]struct {
+		reason        string
+		applications  []string
+		configuration map[string]map[string]string
+		want          []string
+		wantErr       bool
+	}{
+		"PlanFromValues": {
+			reason:        "The plan whose configuration has the values is installed, matching the marketplace name case-insensitively.",
+			applications:  []string{"cert-manager", "mariadb"},
+			configuration: map[string]map[string]string{"mariadb": {"VOLUME_SIZE": "10Gi"}},
+			want:          []string{"cert-manager", "mariadb:10GB"},
+		},
+		"PlanGivenInName": {
+			reason:       "An application given as name:plan without configuration values is passed through.",
+			applications: []string{"mariadb:5GB"},
+			want:         []string{"mariadb:5GB"},
+		},
+		"PlanAndValues": {
+			reason:        "An application cannot be configured both by name:plan and by configuration values.",
+			applications:  []string{"mariadb:5GB"},
+			configuration: map[string]map[string]string{"mariadb": {"VOLUME_SIZE": "10Gi"}},
+			wantErr:       true,
+		},
+		"NoPlanHasValues": {
+			reason:        "Values that no plan offers must not silently install the default plan.",
+			applications:  []string{"mariadb"},
+			configuration: map[string]map[string]string{"mariadb": {"VOLUME_SIZE": "20Gi"}},
+			wantErr:       true,
+		},
+		"UnknownKey": {
+			reason:        "A key that a plan does not configure does not match it.",
+			applications:  []string{"mariadb"},
+			configuration: map[string]map[string]string{"mariadb": {"VOLUME_SIZE": "5Gi", "REPLICAS": "2"}},
+			wantErr:       true,
+		},
+		"NotInMarketplace": {
+			reason:        "Configuration values of an application missing from the marketplace are an error.",
+			applications:  []string{"postgresql"},
+			configuration: map[string]map[string]string{"postgresql": {"VOLUME_SIZE": "5Gi"}},
+			wantErr:       true,
+		},
+		"ValuesOfUnlistedApplication": {
+			reason:        "Values of an application that is not being installed are ignored.",
+			applications:  []string{"cert-manager"},
+			configuration: map[string]map[string]string{"mariadb": {"VOLUME_SIZE": "5Gi"}},
+			want:          []string{"cert-manager"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ConfiguredApplications(tc.applications, tc.configuration, marketplace)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\nConfiguredApplications(...): want error %t, got %v", tc.reason, tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nConfiguredApplications(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
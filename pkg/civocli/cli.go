@@ -6,16 +6,11 @@ import (
 	"github.com/civo/civogo"
 	providerCivoCluster "github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
 	"github.com/crossplane-contrib/provider-civo/apis/civo/instance/v1alpha1"
-	v1alpha1provider "github.com/crossplane-contrib/provider-civo/apis/civo/provider/v1alpha1"
 	v1alpha1volume "github.com/crossplane-contrib/provider-civo/apis/civo/volume/v1alpha1"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
-var (
-	defaultTags = ""
-)
-
 const (
 	// StateActive instance is ready to use
 	StateActive = "ACTIVE"
@@ -29,6 +24,18 @@ type CivoClient struct {
 	civoGoClient *civogo.Client
 }
 
+// isNotFound reports whether err is one of the given civogo not found errors.
+// Civo has no dedicated not found error for some resources and answers with a
+// plain 404 instead, which is matched as well.
+func isNotFound(err error, notFound ...error) bool {
+	for _, target := range notFound {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return strings.Contains(err.Error(), "code: 404")
+}
+
 func emptyIfNil(in *string) string {
 	if in == nil {
 		return ""
@@ -39,9 +46,10 @@ func emptyIfNil(in *string) string {
 // GenerateObservation creates the CivoInstanceObservation from instance infos
 func GenerateObservation(instance *civogo.Instance) (v1alpha1.CivoInstanceObservation, error) {
 	observation := v1alpha1.CivoInstanceObservation{
-		ID:    instance.ID,
-		State: instance.Status,
-		IPv4:  instance.PublicIP,
+		ID:          instance.ID,
+		State:       instance.Status,
+		IPv4:        instance.PublicIP,
+		PrivateIPv4: instance.PrivateIP,
 	}
 
 	if !observation.CreatedAt.IsZero() {
@@ -67,7 +75,12 @@ func GenerateVolumeObservation(volume *civogo.Volume) (*v1alpha1volume.CivoVolum
 
 // NewCivoClient creates a new Civo client.
 func NewCivoClient(apiKey string, region string) (*CivoClient, error) {
+	return NewCivoClientWithURL(apiKey, "https://api.civo.com", region)
+}
 
+// NewCivoClientWithURL creates a new Civo client for the Civo API at the
+// given URL.
+func NewCivoClientWithURL(apiKey, apiURL, region string) (*CivoClient, error) {
 	if apiKey == "" {
 		return nil, errors.New("newCivoClient: apiKey is nil")
 	}
@@ -77,7 +90,7 @@ func NewCivoClient(apiKey string, region string) (*CivoClient, error) {
 	if region == "" {
 		return nil, errors.New("newCivoClient: region is nil")
 	}
-	client, err := civogo.NewClient(apiKey, region)
+	client, err := civogo.NewClientWithURL(apiKey, apiURL, region)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +133,12 @@ func (c *CivoClient) CreateNewInstance(instance *v1alpha1.CivoInstance, sshPubKe
 	config.InitialUser = emptyIfNil(&instance.Spec.InstanceConfig.InitialUser)
 	config.PublicIPRequired = emptyIfNil(&instance.Spec.InstanceConfig.PublicIPRequired)
 
-	if len(sshPubKey) > 0 {
+	switch {
+	case instance.Spec.InstanceConfig.SSHKeyID != "":
+		config.SSHKeyID = instance.Spec.InstanceConfig.SSHKeyID
+	case len(sshPubKey) > 0:
+		// Deprecated: keys uploaded from sshPubKeyRef are keyed by hostname and
+		// are never deleted by the instance, use a CivoSSHKey instead.
 		if sshKey, err := c.civoGoClient.FindSSHKey(config.Hostname); err == nil {
 			config.SSHKeyID = sshKey.ID
 		} else {
@@ -151,15 +169,9 @@ func (c *CivoClient) DeleteInstance(id string) error {
 		return err
 	}
 	resp, err := c.civoGoClient.DeleteInstance(instance.ID)
-	if err != nil {
+	if err != nil && resp != nil {
 		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
 	}
-	if sshKey, err := c.civoGoClient.FindSSHKey(instance.Hostname); err == nil {
-		_, err := c.civoGoClient.DeleteSSHKey(sshKey.ID)
-		if err != nil {
-			return err
-		}
-	}
 	return err
 }
 
@@ -189,89 +201,94 @@ func (c *CivoClient) GetK3sCluster(clusterName string) (*civogo.KubernetesCluste
 }
 
 // CreateNewK3sCluster creates a new K3s cluster on Civo.
-func (c *CivoClient) CreateNewK3sCluster(clusterName string,
-	pools []civogo.KubernetesClusterPoolConfig, applications []string, cni *string, version *string) error {
-
-	// Find the default network ID
-	network, err := c.civoGoClient.GetDefaultNetwork()
-	if err != nil {
-		return err
-	}
+func (c *CivoClient) CreateNewK3sCluster(cluster *providerCivoCluster.CivoKubernetes) error {
+	spec := cluster.Spec
 
-	if len(pools) < 1 {
+	if len(spec.Pools) < 1 {
 		return errors.New("pool is required for CivoKubernetes cluster creation")
 	}
-	// Currently we will only define the initial pool entries to be created with the cluster
-	// This is due to limitations in the API
-	var cp string
-	if cni != nil {
-		cp = *cni
-	} else {
-		cp = "flannel"
+	if spec.FirewallID != "" && spec.CreateFirewallRules != "" {
+		return errors.New("only one of firewallId and createFirewallRules can be set")
 	}
 
-	ver := "1.22.2-k3s1"
-	if version != nil {
-		ver = *version
+	networkID := spec.NetworkID
+	if networkID == "" {
+		// Find the default network ID
+		network, err := c.civoGoClient.GetDefaultNetwork()
+		if err != nil {
+			return err
+		}
+		networkID = network.ID
 	}
 
-	cfg := &civogo.KubernetesClusterConfig{
-		Region:            c.civoGoClient.Region,
-		Name:              clusterName,
-		Tags:              defaultTags,
-		NetworkID:         network.ID,
-		KubernetesVersion: ver,
-		Pools:             pools,
-		Applications:      strings.Join(applications, ","),
-		CNIPlugin:         cp,
+	applications, err := c.ApplicationPlans(spec.Applications, spec.ApplicationConfiguration)
+	if err != nil {
+		return err
 	}
 
+	cfg := ConvertK3sClusterConfig(cluster, c.civoGoClient.Region, networkID)
+	cfg.Applications = strings.Join(applications, ",")
+
 	kubernetesCluster, err := c.civoGoClient.NewKubernetesClusters(cfg)
 	if err != nil {
 		return err
 	}
 
-	log.Debugf("Created Kubernetes cluster %s with %d node pools", kubernetesCluster.Name, len(pools))
+	log.Debugf("Created Kubernetes cluster %s with %d node pools", kubernetesCluster.Name, len(cfg.Pools))
 
 	return nil
 }
 
-// UpdateK3sCluster updates a K3s cluster on Civo.
-func (c *CivoClient) UpdateK3sCluster(desiredCluster *providerCivoCluster.CivoKubernetes,
-	remoteCivoCluster *civogo.KubernetesCluster, provider *v1alpha1provider.ProviderConfig) error {
+// UpdateK3sClusterVersion upgrades a K3s cluster on Civo to a new Kubernetes version.
+func (c *CivoClient) UpdateK3sClusterVersion(clusterID, version string) error {
+	_, err := c.civoGoClient.UpdateKubernetesCluster(clusterID,
+		&civogo.KubernetesClusterConfig{
+			KubernetesVersion: version,
+		})
 
-	// Convert desiredCluster.Spec.Pools to the type expected by civogo package.
-	convertedPools := ConvertKubernetesClusterPoolConfigs(desiredCluster.Spec.Pools)
+	return err
+}
 
-	_, err := c.civoGoClient.UpdateKubernetesCluster(desiredCluster.Spec.Name,
+// UninstallK3sApplications uninstalls marketplace applications from a K3s cluster on Civo.
+func (c *CivoClient) UninstallK3sApplications(clusterID string, applications []string) error {
+	removals := make([]string, 0, len(applications))
+	for _, app := range applications {
+		// Civo removes applications that are prefixed with a dash.
+		removals = append(removals, "-"+app)
+	}
+	_, err := c.civoGoClient.UpdateKubernetesCluster(clusterID,
 		&civogo.KubernetesClusterConfig{
-			Pools: convertedPools,
+			Applications: strings.Join(removals, ","),
 		})
 
 	return err
 }
 
-// UpdateK3sClusterVersion updates a K3s cluster version on Civo.
-func (c *CivoClient) UpdateK3sClusterVersion(desiredCluster *providerCivoCluster.CivoKubernetes,
-	remoteCivoCluster *civogo.KubernetesCluster, provider *v1alpha1provider.ProviderConfig) error {
-
-	_, err := c.civoGoClient.UpdateKubernetesCluster(desiredCluster.Spec.Name,
+// UpdateK3sClusterTags replaces the tags of a K3s cluster on Civo.
+func (c *CivoClient) UpdateK3sClusterTags(clusterID string, tags []string) error {
+	_, err := c.civoGoClient.UpdateKubernetesCluster(clusterID,
 		&civogo.KubernetesClusterConfig{
-			KubernetesVersion: *desiredCluster.Spec.Version,
+			Tags: strings.Join(tags, " "),
 		})
 
 	return err
 }
 
-// DeleteK3sCluster deletes a k3s cluster on Civo.
-func (c *CivoClient) DeleteK3sCluster(name string) error {
+// InstallK3sApplications installs marketplace applications on a K3s cluster on Civo.
+func (c *CivoClient) InstallK3sApplications(clusterID string, applications []string) error {
+	_, err := c.civoGoClient.UpdateKubernetesCluster(clusterID,
+		&civogo.KubernetesClusterConfig{
+			Applications: strings.Join(applications, ","),
+		})
+
+	return err
+}
 
-	kubernetesCluster, err := c.GetK3sCluster(name)
-	if err != nil {
-		return err
-	}
-	resp, err := c.civoGoClient.DeleteKubernetesCluster(kubernetesCluster.ID)
-	if err != nil {
+// DeleteK3sCluster deletes a k3s cluster on Civo. Civo removes the cluster
+// asynchronously.
+func (c *CivoClient) DeleteK3sCluster(id string) error {
+	resp, err := c.civoGoClient.DeleteKubernetesCluster(id)
+	if err != nil && resp != nil {
 		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
 	}
 	return err
@@ -279,6 +296,14 @@ func (c *CivoClient) DeleteK3sCluster(name string) error {
 
 // CreateVolume creates a volume on Civo.
 func (c *CivoClient) CreateVolume(name string, size int, networkID string, clusterID string, bootable bool) (*civogo.VolumeResult, error) {
+	if networkID == "" {
+		// Find the default network ID
+		network, err := c.civoGoClient.GetDefaultNetwork()
+		if err != nil {
+			return nil, err
+		}
+		networkID = network.ID
+	}
 
 	cfgs := civogo.VolumeConfig{
 		Name:          name,
@@ -0,0 +1,104 @@
+package civocli
+
+import (
+	"github.com/civo/civogo"
+	v1alpha1database "github.com/crossplane-contrib/provider-civo/apis/civo/database/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateDatabaseObservation creates the CivoDatabaseObservation from database infos
+func GenerateDatabaseObservation(database *civogo.Database) v1alpha1database.CivoDatabaseObservation {
+	return v1alpha1database.CivoDatabaseObservation{
+		ID:         database.ID,
+		Name:       database.Name,
+		Engine:     database.Software,
+		Version:    database.SoftwareVersion,
+		Size:       database.Size,
+		Nodes:      database.Nodes,
+		PublicIPv4: database.PublicIPv4,
+		DNSEntry:   database.DNSEntry,
+		Port:       database.Port,
+		NetworkID:  database.NetworkID,
+		FirewallID: database.FirewallID,
+		Status:     database.Status,
+	}
+}
+
+// GetDatabase gets a managed database on Civo.
+func (c *CivoClient) GetDatabase(id string) (*civogo.Database, error) {
+	if id == "" {
+		return nil, nil
+	}
+	database, err := c.civoGoClient.GetDatabase(id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return database, nil
+}
+
+// CreateDatabase creates a new managed database on Civo.
+func (c *CivoClient) CreateDatabase(database *v1alpha1database.CivoDatabase) (*civogo.Database, error) {
+	result, err := c.civoGoClient.NewDatabase(&civogo.CreateDatabaseRequest{
+		Name:            database.Spec.Name,
+		Size:            database.Spec.Size,
+		Software:        database.Spec.Engine,
+		SoftwareVersion: emptyIfNil(database.Spec.Version),
+		NetworkID:       database.Spec.NetworkID,
+		Nodes:           database.Spec.Nodes,
+		FirewallID:      database.Spec.FirewallID,
+		FirewallRules:   database.Spec.FirewallRules,
+		Region:          database.Spec.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created database %s", result.Name)
+
+	return result, nil
+}
+
+// UpdateDatabase updates the name, node count and firewall of a managed database on Civo.
+func (c *CivoClient) UpdateDatabase(id string, database *v1alpha1database.CivoDatabase) error {
+	nodes := database.Spec.Nodes
+	_, err := c.civoGoClient.UpdateDatabase(id, &civogo.UpdateDatabaseRequest{
+		Name:       database.Spec.Name,
+		Nodes:      &nodes,
+		FirewallID: database.Spec.FirewallID,
+		Region:     database.Spec.Region,
+	})
+	return err
+}
+
+// DeleteDatabase deletes a managed database on Civo.
+func (c *CivoClient) DeleteDatabase(id string) error {
+	database, err := c.GetDatabase(id)
+	if err != nil {
+		return err
+	}
+	if database == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteDatabase(database.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// RestoreDatabase restores the backup in the spec of a managed database. The
+// restore is requested from the database that owns the backup.
+func (c *CivoClient) RestoreDatabase(database *v1alpha1database.CivoDatabase) error {
+	resp, err := c.civoGoClient.RestoreDatabase(database.Spec.RestoreFrom.DatabaseID, &civogo.RestoreDatabaseRequest{
+		Name:   database.Spec.Name,
+		Backup: database.Spec.RestoreFrom.Backup,
+		Region: database.Spec.Region,
+	})
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package civocli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+	v1alpha1database "github.com/crossplane-contrib/provider-civo/apis/civo/database/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// databaseBackupTypeManual is the backup type Civo expects for backups that are taken once
+	databaseBackupTypeManual = "manual"
+)
+
+// GenerateDatabaseBackupObservation creates the CivoDatabaseBackupObservation from backup infos
+func GenerateDatabaseBackupObservation(backup *civogo.DatabaseBackup) v1alpha1database.CivoDatabaseBackupObservation {
+	observation := v1alpha1database.CivoDatabaseBackupObservation{
+		ID:           backup.ID,
+		Name:         backup.Name,
+		DatabaseID:   backup.DatabaseID,
+		DatabaseName: backup.DatabaseName,
+		Scheduled:    backup.IsScheduled,
+		Schedule:     backup.Schedule,
+		Status:       backup.Status,
+	}
+	if !backup.CreatedAt.IsZero() {
+		createdAt := metav1.NewTime(backup.CreatedAt)
+		observation.CreatedAt = &createdAt
+	}
+	return observation
+}
+
+// GetDatabaseBackup gets a backup of a managed database on Civo.
+func (c *CivoClient) GetDatabaseBackup(databaseID, id string) (*civogo.DatabaseBackup, error) {
+	if databaseID == "" || id == "" {
+		return nil, nil
+	}
+	backup, err := c.civoGoClient.GetDatabaseBackup(databaseID, id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return backup, nil
+}
+
+// CreateDatabaseBackup takes a manual backup of a managed database on Civo, or
+// schedules backups when the spec has a schedule.
+func (c *CivoClient) CreateDatabaseBackup(backup *v1alpha1database.CivoDatabaseBackup) (*civogo.DatabaseBackup, error) {
+	req := &civogo.DatabaseBackupCreateRequest{
+		Name:     backup.Spec.Name,
+		Schedule: backup.Spec.Schedule,
+		Region:   backup.Spec.Region,
+	}
+	if backup.Spec.Schedule == "" {
+		req.Type = databaseBackupTypeManual
+	}
+	result, err := c.civoGoClient.CreateDatabaseBackup(backup.Spec.DatabaseID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created backup %s of database %s", result.Name, backup.Spec.DatabaseID)
+
+	return result, nil
+}
+
+// UpdateDatabaseBackup updates the schedule of a scheduled backup on Civo.
+func (c *CivoClient) UpdateDatabaseBackup(backup *v1alpha1database.CivoDatabaseBackup) error {
+	_, err := c.civoGoClient.UpdateDatabaseBackup(backup.Spec.DatabaseID, &civogo.DatabaseBackupUpdateRequest{
+		Name:     backup.Spec.Name,
+		Schedule: backup.Spec.Schedule,
+		Region:   backup.Spec.Region,
+	})
+	return err
+}
+
+// DeleteDatabaseBackup deletes a backup of a managed database on Civo.
+func (c *CivoClient) DeleteDatabaseBackup(databaseID, id string) error {
+	backup, err := c.GetDatabaseBackup(databaseID, id)
+	if err != nil {
+		return err
+	}
+	if backup == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteDatabaseBackup(databaseID, backup.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// ExpiredDatabaseBackups returns the backups taken by the schedule with the
+// given ID that exceed the retention count, oldest first.
+func (c *CivoClient) ExpiredDatabaseBackups(backup *v1alpha1database.CivoDatabaseBackup, scheduleID string, retentionCount int) ([]civogo.DatabaseBackup, error) {
+	backups, err := c.civoGoClient.ListDatabaseBackup(backup.Spec.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	var taken []civogo.DatabaseBackup
+	for _, b := range backups.Items {
+		if b.ID != scheduleID && b.IsScheduled && strings.HasPrefix(b.Name, backup.Spec.Name) {
+			taken = append(taken, b)
+		}
+	}
+	if len(taken) <= retentionCount {
+		return nil, nil
+	}
+	sort.Slice(taken, func(i, j int) bool {
+		return taken[i].CreatedAt.Before(taken[j].CreatedAt)
+	})
+	return taken[:len(taken)-retentionCount], nil
+}
@@ -0,0 +1,147 @@
+package civocli
+
+import (
+	"github.com/civo/civogo"
+	v1alpha1dns "github.com/crossplane-contrib/provider-civo/apis/civo/dns/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateDNSDomainObservation creates the CivoDNSDomainObservation from domain infos
+func GenerateDNSDomainObservation(domain *civogo.DNSDomain) v1alpha1dns.CivoDNSDomainObservation {
+	return v1alpha1dns.CivoDNSDomainObservation{
+		ID:   domain.ID,
+		Name: domain.Name,
+	}
+}
+
+// GenerateDNSRecordObservation creates the CivoDNSRecordObservation from record infos
+func GenerateDNSRecordObservation(record *civogo.DNSRecord) v1alpha1dns.CivoDNSRecordObservation {
+	return v1alpha1dns.CivoDNSRecordObservation{
+		ID:       record.ID,
+		DomainID: record.DNSDomainID,
+		Name:     record.Name,
+		Type:     string(record.Type),
+		Value:    record.Value,
+		Priority: record.Priority,
+		TTL:      record.TTL,
+	}
+}
+
+// GetDNSDomain gets a DNS domain on Civo by its ID.
+func (c *CivoClient) GetDNSDomain(id string) (*civogo.DNSDomain, error) {
+	if id == "" {
+		return nil, nil
+	}
+	domains, err := c.civoGoClient.ListDNSDomains()
+	if err != nil {
+		return nil, err
+	}
+	for i := range domains {
+		if domains[i].ID == id {
+			return &domains[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateDNSDomain creates a new DNS domain on Civo.
+func (c *CivoClient) CreateDNSDomain(name string) (*civogo.DNSDomain, error) {
+	domain, err := c.civoGoClient.CreateDNSDomain(name)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created DNS domain %s", domain.Name)
+
+	return domain, nil
+}
+
+// RenameDNSDomain renames a DNS domain on Civo.
+func (c *CivoClient) RenameDNSDomain(domain *civogo.DNSDomain, name string) error {
+	_, err := c.civoGoClient.UpdateDNSDomain(domain, name)
+	return err
+}
+
+// DeleteDNSDomain deletes a DNS domain on Civo.
+func (c *CivoClient) DeleteDNSDomain(id string) error {
+	domain, err := c.GetDNSDomain(id)
+	if err != nil {
+		return err
+	}
+	if domain == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteDNSDomain(domain)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// GetDNSRecord gets a DNS record on Civo by the ID of its domain and its own ID.
+func (c *CivoClient) GetDNSRecord(domainID, id string) (*civogo.DNSRecord, error) {
+	if domainID == "" || id == "" {
+		return nil, nil
+	}
+	records, err := c.civoGoClient.ListDNSRecords(domainID)
+	if err != nil {
+		// The record is gone with its domain as well.
+		if isNotFound(err, civogo.DatabaseDNSDomainNotFoundError, civogo.DatabaseDNSRecordNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range records {
+		if records[i].ID == id {
+			// The record is addressed through its domain, make sure the ID is
+			// always set so the record can be updated and deleted.
+			records[i].DNSDomainID = domainID
+			return &records[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateDNSRecord creates a new DNS record with the given value on Civo.
+func (c *CivoClient) CreateDNSRecord(record *v1alpha1dns.CivoDNSRecord, value string) (*civogo.DNSRecord, error) {
+	result, err := c.civoGoClient.CreateDNSRecord(record.Spec.DomainID, convertDNSRecord(record, value))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created DNS record %s %s", result.Type, result.Name)
+
+	return result, nil
+}
+
+// UpdateDNSRecord updates a DNS record on Civo to match the spec and the given value.
+func (c *CivoClient) UpdateDNSRecord(remote *civogo.DNSRecord, record *v1alpha1dns.CivoDNSRecord, value string) error {
+	_, err := c.civoGoClient.UpdateDNSRecord(remote, convertDNSRecord(record, value))
+	return err
+}
+
+// DeleteDNSRecord deletes a DNS record on Civo.
+func (c *CivoClient) DeleteDNSRecord(domainID, id string) error {
+	record, err := c.GetDNSRecord(domainID, id)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteDNSRecord(record)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+func convertDNSRecord(record *v1alpha1dns.CivoDNSRecord, value string) *civogo.DNSRecordConfig {
+	return &civogo.DNSRecordConfig{
+		Type:     civogo.DNSRecordType(record.Spec.Type),
+		Name:     record.Spec.Name,
+		Value:    value,
+		Priority: record.Spec.Priority,
+		TTL:      record.Spec.TTL,
+	}
+}
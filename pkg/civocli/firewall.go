@@ -0,0 +1,207 @@
+package civocli
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/civo/civogo"
+	v1alpha1firewall "github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// firewallRuleActionAllow is the only action the Civo API accepts for rules
+	firewallRuleActionAllow = "allow"
+)
+
+// GenerateFirewallObservation creates the CivoFirewallObservation from firewall and rule infos
+func GenerateFirewallObservation(firewall *civogo.Firewall, rules []civogo.FirewallRule) v1alpha1firewall.CivoFirewallObservation {
+	instanceCount := firewall.InstanceCount
+	observation := v1alpha1firewall.CivoFirewallObservation{
+		ID:            firewall.ID,
+		InstanceCount: &instanceCount,
+		RulesCount:    len(rules),
+	}
+	for _, rule := range rules {
+		observation.Rules = append(observation.Rules, v1alpha1firewall.FirewallRuleObservation{
+			ID:        rule.ID,
+			Protocol:  rule.Protocol,
+			StartPort: rule.StartPort,
+			EndPort:   rule.EndPort,
+			CIDR:      rule.Cidr,
+			Direction: rule.Direction,
+			Label:     rule.Label,
+		})
+	}
+	return observation
+}
+
+// DiffFirewallRules compares the desired rules with the rules applied in Civo and
+// returns the rules that have to be created and the remote rules that have to be
+// deleted. Civo rules cannot be edited in place, so a changed rule shows up in both.
+func DiffFirewallRules(desired []v1alpha1firewall.FirewallRule, remote []civogo.FirewallRule) ([]v1alpha1firewall.FirewallRule, []civogo.FirewallRule) {
+	unmatched := make(map[string][]civogo.FirewallRule, len(remote))
+	for _, rule := range remote {
+		key := firewallRuleKey(rule.Protocol, rule.StartPort, rule.EndPort, rule.Cidr, rule.Direction, rule.Label)
+		unmatched[key] = append(unmatched[key], rule)
+	}
+
+	var toCreate []v1alpha1firewall.FirewallRule
+	for _, rule := range desired {
+		cfg := ConvertFirewallRule("", rule)
+		key := firewallRuleKey(cfg.Protocol, cfg.StartPort, cfg.EndPort, cfg.Cidr, cfg.Direction, cfg.Label)
+		if matches := unmatched[key]; len(matches) > 0 {
+			unmatched[key] = matches[1:]
+			continue
+		}
+		toCreate = append(toCreate, rule)
+	}
+
+	var toDelete []civogo.FirewallRule
+	for _, rule := range remote {
+		key := firewallRuleKey(rule.Protocol, rule.StartPort, rule.EndPort, rule.Cidr, rule.Direction, rule.Label)
+		for _, leftover := range unmatched[key] {
+			if leftover.ID == rule.ID {
+				toDelete = append(toDelete, rule)
+				break
+			}
+		}
+	}
+	return toCreate, toDelete
+}
+
+// firewallRuleKey builds a comparable identity for a rule out of the fields
+// that Civo considers when applying it. ICMP rules have no ports: Civo returns
+// them empty while the spec has a start port of 0.
+func firewallRuleKey(protocol, startPort, endPort string, cidr []string, direction, label string) string {
+	if strings.EqualFold(protocol, "icmp") {
+		startPort, endPort = "", ""
+	}
+	if startPort == "" {
+		startPort = "0"
+	}
+	if endPort == "" {
+		endPort = startPort
+	}
+	cidrs := append([]string(nil), cidr...)
+	sort.Strings(cidrs)
+	return strings.Join([]string{
+		strings.ToLower(protocol),
+		startPort,
+		endPort,
+		strings.Join(cidrs, ","),
+		strings.ToLower(direction),
+		label,
+	}, "|")
+}
+
+// ConvertFirewallRule converts a FirewallRule from the provider-civo package to the
+// FirewallRuleConfig expected by the civogo package.
+func ConvertFirewallRule(firewallID string, rule v1alpha1firewall.FirewallRule) *civogo.FirewallRuleConfig {
+	endPort := rule.StartPort
+	if rule.EndPort != nil {
+		endPort = *rule.EndPort
+	}
+	return &civogo.FirewallRuleConfig{
+		FirewallID: firewallID,
+		Protocol:   strings.ToLower(rule.Protocol),
+		StartPort:  strconv.Itoa(rule.StartPort),
+		EndPort:    strconv.Itoa(endPort),
+		Cidr:       []string{rule.CIDR},
+		Direction:  rule.Direction,
+		Action:     firewallRuleActionAllow,
+		Label:      rule.Label,
+	}
+}
+
+// GetFirewall gets a firewall on Civo.
+func (c *CivoClient) GetFirewall(id string) (*civogo.Firewall, error) {
+	if id == "" {
+		return nil, nil
+	}
+	firewalls, err := c.civoGoClient.ListFirewalls()
+	if err != nil {
+		return nil, err
+	}
+	for i := range firewalls {
+		if firewalls[i].ID == id {
+			return &firewalls[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateFirewall creates a new firewall on Civo together with its rules.
+// Civo's default rules are never created so that the firewall only ever
+// contains the rules listed in the spec.
+func (c *CivoClient) CreateFirewall(firewall *v1alpha1firewall.CivoFirewall) (*civogo.FirewallResult, error) {
+	createRules := false
+	result, err := c.civoGoClient.NewFirewall(&civogo.FirewallConfig{
+		Name:        firewall.Spec.Name,
+		Region:      firewall.Spec.Region,
+		NetworkID:   firewall.Spec.NetworkID,
+		CreateRules: &createRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range firewall.Spec.Rules {
+		if _, err := c.civoGoClient.NewFirewallRule(ConvertFirewallRule(result.ID, rule)); err != nil {
+			return result, errors.Wrapf(err, "cannot create rule for firewall %s", result.ID)
+		}
+	}
+
+	log.Debugf("Created firewall %s with %d rules", result.Name, len(firewall.Spec.Rules))
+
+	return result, nil
+}
+
+// GetFirewallRules lists the rules of a firewall on Civo.
+func (c *CivoClient) GetFirewallRules(id string) ([]civogo.FirewallRule, error) {
+	return c.civoGoClient.ListFirewallRules(id)
+}
+
+// CreateFirewallRule adds a rule to a firewall on Civo.
+func (c *CivoClient) CreateFirewallRule(id string, rule v1alpha1firewall.FirewallRule) error {
+	_, err := c.civoGoClient.NewFirewallRule(ConvertFirewallRule(id, rule))
+	return err
+}
+
+// DeleteFirewallRule removes a rule from a firewall on Civo.
+func (c *CivoClient) DeleteFirewallRule(id string, ruleID string) error {
+	resp, err := c.civoGoClient.DeleteFirewallRule(id, ruleID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// RenameFirewall renames a firewall on Civo.
+func (c *CivoClient) RenameFirewall(id string, name string) error {
+	resp, err := c.civoGoClient.RenameFirewall(id, &civogo.FirewallConfig{
+		Name: name,
+	})
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// DeleteFirewall deletes a firewall on Civo.
+func (c *CivoClient) DeleteFirewall(id string) error {
+	firewall, err := c.GetFirewall(id)
+	if err != nil {
+		return err
+	}
+	if firewall == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteFirewall(firewall.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+package civocli
+
+import (
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/google/go-cmp/cmp"
+
+	v1alpha1firewall "github.com/crossplane-contrib/provider-civo/apis/civo/firewall/v1alpha1"
+)
+
+func TestDiffFirewallRules(t *testing.T) {
+	port := func(p int) *int { return &p }
+	ssh := v1alpha1firewall.FirewallRule{Protocol: "TCP", StartPort: 22, CIDR: "0.0.0.0/0", Direction: "ingress", Label: "ssh"}
+	web := v1alpha1firewall.FirewallRule{Protocol: "tcp", StartPort: 80, EndPort: port(443), CIDR: "0.0.0.0/0", Direction: "ingress", Label: "web"}
+	remoteSSH := civogo.FirewallRule{ID: "1", Protocol: "tcp", StartPort: "22", EndPort: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "ssh"}
+	remoteWeb := civogo.FirewallRule{ID: "2", Protocol: "tcp", StartPort: "80", EndPort: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "web"}
+
+	icmp := v1alpha1firewall.FirewallRule{Protocol: "icmp", CIDR: "0.0.0.0/0", Direction: "ingress", Label: "ping"}
+	remoteICMP := civogo.FirewallRule{ID: "3", Protocol: "icmp", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "ping"}
+
+	type want struct {
+		toCreate []v1alpha1firewall.FirewallRule
+		toDelete []civogo.FirewallRule
+	}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1alpha1firewall.FirewallRule
+		remote  []civogo.FirewallRule
+		want    want
+	}{
+		"ReorderedRules": {
+			reason:  "Rules are matched by content, so their order and the case of the protocol do not matter.",
+			desired: []v1alpha1firewall.FirewallRule{web, ssh},
+			remote:  []civogo.FirewallRule{remoteSSH, remoteWeb},
+		},
+		"SinglePortWithoutEndPort": {
+			reason:  "Civo may leave the end port of a single port rule empty.",
+			desired: []v1alpha1firewall.FirewallRule{ssh},
+			remote:  []civogo.FirewallRule{{ID: "1", Protocol: "tcp", StartPort: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "ssh"}},
+		},
+		"ICMPWithoutPorts": {
+			reason:  "An ICMP rule has a start port of 0 in the spec and no ports on Civo; it must not be recreated on every reconcile.",
+			desired: []v1alpha1firewall.FirewallRule{ssh, icmp},
+			remote:  []civogo.FirewallRule{remoteICMP, remoteSSH},
+		},
+		"ICMPAdded": {
+			reason:  "A missing ICMP rule is created even though it has no ports.",
+			desired: []v1alpha1firewall.FirewallRule{ssh, icmp},
+			remote:  []civogo.FirewallRule{remoteSSH},
+			want:    want{toCreate: []v1alpha1firewall.FirewallRule{icmp}},
+		},
+		"WebOpened": {
+			reason:  "A rule added to the spec is created and the existing ones are kept.",
+			desired: []v1alpha1firewall.FirewallRule{ssh, web},
+			remote:  []civogo.FirewallRule{remoteSSH},
+			want:    want{toCreate: []v1alpha1firewall.FirewallRule{web}},
+		},
+		"AllRulesRemoved": {
+			reason:  "An empty rule list removes every rule from the firewall.",
+			desired: []v1alpha1firewall.FirewallRule{},
+			remote:  []civogo.FirewallRule{remoteSSH, remoteWeb, remoteICMP},
+			want:    want{toDelete: []civogo.FirewallRule{remoteSSH, remoteWeb, remoteICMP}},
+		},
+		"SSHRestrictedToPrivateRange": {
+			reason:  "Civo cannot update a rule, so a changed CIDR replaces it.",
+			desired: []v1alpha1firewall.FirewallRule{{Protocol: "tcp", StartPort: 22, CIDR: "10.0.0.0/8", Direction: "ingress", Label: "ssh"}},
+			remote:  []civogo.FirewallRule{remoteSSH},
+			want: want{
+				toCreate: []v1alpha1firewall.FirewallRule{{Protocol: "tcp", StartPort: 22, CIDR: "10.0.0.0/8", Direction: "ingress", Label: "ssh"}},
+				toDelete: []civogo.FirewallRule{remoteSSH},
+			},
+		},
+		"DuplicateOnCivo": {
+			reason:  "Only one of two identical remote rules is kept for a single rule in the spec.",
+			desired: []v1alpha1firewall.FirewallRule{ssh},
+			remote:  []civogo.FirewallRule{remoteSSH, {ID: "4", Protocol: "tcp", StartPort: "22", EndPort: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "ssh"}},
+			want:    want{toDelete: []civogo.FirewallRule{{ID: "4", Protocol: "tcp", StartPort: "22", EndPort: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "ssh"}}},
+		},
+		"DuplicateInSpec": {
+			reason:  "A rule listed twice in the spec needs two rules on Civo.",
+			desired: []v1alpha1firewall.FirewallRule{ssh, ssh},
+			remote:  []civogo.FirewallRule{remoteSSH},
+			want:    want{toCreate: []v1alpha1firewall.FirewallRule{ssh}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			toCreate, toDelete := DiffFirewallRules(tc.desired, tc.remote)
+			if diff := cmp.Diff(tc.want.toCreate, toCreate); diff != "" {
+				t.Errorf("\n%s\nDiffFirewallRules(...): -want toCreate, +got toCreate:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.toDelete, toDelete); diff != "" {
+				t.Errorf("\n%s\nDiffFirewallRules(...): -want toDelete, +got toDelete:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
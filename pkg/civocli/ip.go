@@ -0,0 +1,111 @@
+package civocli
+
+import (
+	"github.com/civo/civogo"
+	v1alpha1ip "github.com/crossplane-contrib/provider-civo/apis/civo/ip/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ReservedIPTypeInstance is the resource type used to assign a reserved IP to an instance
+	ReservedIPTypeInstance = "instance"
+	// ReservedIPTypeLoadBalancer is the resource type used to assign a reserved IP to a load balancer
+	ReservedIPTypeLoadBalancer = "loadbalancer"
+)
+
+// GenerateReservedIPObservation creates the CivoReservedIPObservation from reserved IP infos
+func GenerateReservedIPObservation(ip *civogo.IP) v1alpha1ip.CivoReservedIPObservation {
+	observation := v1alpha1ip.CivoReservedIPObservation{
+		ID:   ip.ID,
+		Name: ip.Name,
+		IP:   ip.IP,
+	}
+	if ip.AssignedTo.ID != "" {
+		observation.AssignedTo = &v1alpha1ip.ReservedIPAssignee{
+			ID:   ip.AssignedTo.ID,
+			Type: ip.AssignedTo.Type,
+			Name: ip.AssignedTo.Name,
+		}
+	}
+	return observation
+}
+
+// GetReservedIP gets a reserved IP on Civo.
+func (c *CivoClient) GetReservedIP(id string) (*civogo.IP, error) {
+	if id == "" {
+		return nil, nil
+	}
+	ip, err := c.civoGoClient.GetIP(id)
+	if err != nil {
+		if isNotFound(err, civogo.DatabaseIPFindError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ip, nil
+}
+
+// CreateReservedIP allocates a new reserved IP on Civo.
+func (c *CivoClient) CreateReservedIP(ip *v1alpha1ip.CivoReservedIP) (*civogo.IP, error) {
+	result, err := c.civoGoClient.NewIP(&civogo.CreateIPRequest{
+		Name:   ip.Spec.Name,
+		Region: ip.Spec.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created reserved IP %s", result.IP)
+
+	return result, nil
+}
+
+// RenameReservedIP renames a reserved IP on Civo.
+func (c *CivoClient) RenameReservedIP(id string, ip *v1alpha1ip.CivoReservedIP) error {
+	_, err := c.civoGoClient.UpdateIP(id, &civogo.UpdateIPRequest{
+		Name:   ip.Spec.Name,
+		Region: ip.Spec.Region,
+	})
+	return err
+}
+
+// AssignReservedIP assigns a reserved IP to an instance or a load balancer on Civo.
+// A reserved IP that is already assigned elsewhere is moved.
+func (c *CivoClient) AssignReservedIP(id string, resourceID string, resourceType string) error {
+	resp, err := c.civoGoClient.AssignIP(id, resourceID, resourceType, c.civoGoClient.Region)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// UnassignReservedIP removes a reserved IP from the resource it is assigned to on Civo.
+func (c *CivoClient) UnassignReservedIP(id string) error {
+	resp, err := c.civoGoClient.UnassignIP(id, c.civoGoClient.Region)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// DeleteReservedIP releases a reserved IP on Civo. The IP is unassigned first
+// because Civo refuses to delete an IP that is still in use.
+func (c *CivoClient) DeleteReservedIP(id string) error {
+	ip, err := c.GetReservedIP(id)
+	if err != nil {
+		return err
+	}
+	if ip == nil {
+		return nil
+	}
+	if ip.AssignedTo.ID != "" {
+		if err := c.UnassignReservedIP(ip.ID); err != nil {
+			return err
+		}
+	}
+	resp, err := c.civoGoClient.DeleteIP(ip.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
@@ -1,6 +1,11 @@
 package civocli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
 	"github.com/civo/civogo"
 	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
 )
@@ -22,3 +27,83 @@ func ConvertKubernetesClusterPoolConfigs(pools []v1alpha1.KubernetesClusterPoolC
 	}
 	return convertedPools
 }
+
+// ConvertK3sClusterConfig converts the spec of a CivoKubernetes to the
+// KubernetesClusterConfig used to create the cluster on Civo. Civo picks its
+// default Kubernetes version when the spec does not set one.
+func ConvertK3sClusterConfig(cluster *v1alpha1.CivoKubernetes, region, networkID string) *civogo.KubernetesClusterConfig {
+	spec := cluster.Spec
+
+	// Currently we will only define the initial pool entries to be created with the cluster
+	// This is due to limitations in the API
+	pools := ConvertKubernetesClusterPoolConfigs(spec.Pools)
+
+	var cp string
+	if spec.CNIPlugin != nil {
+		cp = *spec.CNIPlugin
+	} else {
+		cp = "flannel"
+	}
+
+	return &civogo.KubernetesClusterConfig{
+		Region:            region,
+		Name:              spec.Name,
+		Tags:              strings.Join(K3sClusterTags(cluster), " "),
+		NetworkID:         networkID,
+		KubernetesVersion: emptyIfNil(spec.Version),
+		Pools:             pools,
+		Applications:      strings.Join(spec.Applications, ","),
+		CNIPlugin:         cp,
+		InstanceFirewall:  spec.FirewallID,
+		FirewallRule:      spec.CreateFirewallRules,
+	}
+}
+
+// GenerateKubernetesObservation creates the CivoKubernetesObservation from cluster infos
+func GenerateKubernetesObservation(cluster *civogo.KubernetesCluster) v1alpha1.CivoKubernetesObservation {
+	applications := make([]v1alpha1.KubernetesApplicationObservation, 0, len(cluster.InstalledApplications))
+	for _, app := range cluster.InstalledApplications {
+		applications = append(applications, v1alpha1.KubernetesApplicationObservation{
+			Name:      app.Name,
+			Version:   app.Version,
+			Installed: app.Installed,
+		})
+	}
+	return v1alpha1.CivoKubernetesObservation{
+		ID:                    cluster.ID,
+		Status:                cluster.Status,
+		MasterIP:              cluster.MasterIP,
+		APIEndpoint:           cluster.APIEndPoint,
+		DNSEntry:              cluster.DNSEntry,
+		Version:               cluster.Version,
+		UpgradeAvailableTo:    cluster.UpgradeAvailableTo,
+		FirewallID:            cluster.FirewallID,
+		NetworkID:             cluster.NetworkID,
+		Tags:                  cluster.Tags,
+		KubeconfigHash:        kubeconfigHash(cluster.KubeConfig),
+		InstalledApplications: applications,
+		Pools:                 GenerateKubernetesPoolObservations(cluster.Pools),
+	}
+}
+
+// kubeconfigHash returns the hex encoded SHA-256 hash of a kubeconfig, or an
+// empty string if there is no kubeconfig.
+func kubeconfigHash(kubeconfig string) string {
+	if kubeconfig == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(kubeconfig))
+	return hex.EncodeToString(sum[:])
+}
+
+// K3sClusterTags returns the tags a K3s cluster should have on Civo: the tags
+// of the spec followed by the provenance tags, unless they are disabled.
+func K3sClusterTags(cluster *v1alpha1.CivoKubernetes) []string {
+	tags := append([]string{}, cluster.Spec.Tags...)
+	if cluster.Spec.ProvenanceTags == nil || *cluster.Spec.ProvenanceTags {
+		tags = append(tags,
+			fmt.Sprintf("%s=%s", v1alpha1.TagKeyName, cluster.GetName()),
+			fmt.Sprintf("%s=%s", v1alpha1.TagKeyUID, cluster.GetUID()))
+	}
+	return tags
+}
@@ -0,0 +1,57 @@
+package civocli
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+)
+
+func TestConvertK3sClusterConfig(t *testing.T) {
+	version := "1.28.7-k3s1"
+	cilium := "cilium"
+	noProvenance := false
+
+	cases := map[string]struct {
+		reason  string
+		spec    v1alpha1.CivoKubernetesSpec
+		version string
+		cni     string
+	}{
+		"VersionUnset": {
+			reason:  "Civo picks its default version when the spec has none.",
+			spec:    v1alpha1.CivoKubernetesSpec{Name: "test"},
+			version: "",
+			cni:     "flannel",
+		},
+		"VersionSet": {
+			reason:  "The version of the spec is passed through.",
+			spec:    v1alpha1.CivoKubernetesSpec{Name: "test", Version: &version},
+			version: version,
+			cni:     "flannel",
+		},
+		"CNISet": {
+			reason: "The CNI plugin of the spec replaces the flannel default.",
+			spec:   v1alpha1.CivoKubernetesSpec{Name: "test", CNIPlugin: &cilium},
+			cni:    cilium,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.spec.ProvenanceTags = &noProvenance
+			cr := &v1alpha1.CivoKubernetes{Spec: tc.spec}
+			got := ConvertK3sClusterConfig(cr, "LON1", "network")
+			if got.KubernetesVersion != tc.version {
+				t.Errorf("%s\nConvertK3sClusterConfig(...).KubernetesVersion: want %q, got %q", tc.reason, tc.version, got.KubernetesVersion)
+			}
+			if got.CNIPlugin != tc.cni {
+				t.Errorf("%s\nConvertK3sClusterConfig(...).CNIPlugin: want %q, got %q", tc.reason, tc.cni, got.CNIPlugin)
+			}
+			if diff := cmp.Diff([]string{"LON1", "network", "test"}, []string{got.Region, got.NetworkID, got.Name}); diff != "" {
+				t.Errorf("%s\nConvertK3sClusterConfig(...): -want region, network, name, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package civocli
+
+import (
+	"github.com/civo/civogo"
+	v1alpha1loadbalancer "github.com/crossplane-contrib/provider-civo/apis/civo/loadbalancer/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateLoadBalancerObservation creates the CivoLoadBalancerObservation from load balancer infos
+func GenerateLoadBalancerObservation(lb *civogo.LoadBalancer) v1alpha1loadbalancer.CivoLoadBalancerObservation {
+	return v1alpha1loadbalancer.CivoLoadBalancerObservation{
+		ID:            lb.ID,
+		State:         lb.State,
+		PublicIP:      lb.PublicIP,
+		PrivateIP:     lb.PrivateIP,
+		FirewallID:    lb.FirewallID,
+		ReservedIP:    lb.ReservedIP,
+		BackendsCount: len(lb.Backends),
+	}
+}
+
+// ConvertLoadBalancerBackends converts a slice of LoadBalancerBackend from the provider-civo
+// package to a slice of LoadBalancerBackendConfig from the civogo package.
+func ConvertLoadBalancerBackends(backends []v1alpha1loadbalancer.LoadBalancerBackend) []civogo.LoadBalancerBackendConfig {
+	convertedBackends := make([]civogo.LoadBalancerBackendConfig, len(backends))
+	for i, backend := range backends {
+		convertedBackends[i] = civogo.LoadBalancerBackendConfig{
+			IP:              backend.IP,
+			Protocol:        backend.Protocol,
+			SourcePort:      backend.SourcePort,
+			TargetPort:      backend.TargetPort,
+			HealthCheckPort: backend.HealthCheckPort,
+		}
+	}
+	return convertedBackends
+}
+
+// GetLoadBalancer gets a load balancer on Civo.
+func (c *CivoClient) GetLoadBalancer(id string) (*civogo.LoadBalancer, error) {
+	if id == "" {
+		return nil, nil
+	}
+	lb, err := c.civoGoClient.GetLoadBalancer(id)
+	if err != nil {
+		if isNotFound(err, civogo.DatabaseLoadBalancerNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return lb, nil
+}
+
+// CreateLoadBalancer creates a new load balancer on Civo.
+func (c *CivoClient) CreateLoadBalancer(lb *v1alpha1loadbalancer.CivoLoadBalancer) (*civogo.LoadBalancer, error) {
+	result, err := c.civoGoClient.CreateLoadBalancer(&civogo.LoadBalancerConfig{
+		Region:                lb.Spec.Region,
+		Name:                  lb.Spec.Name,
+		NetworkID:             lb.Spec.NetworkID,
+		Algorithm:             lb.Spec.Algorithm,
+		Backends:              ConvertLoadBalancerBackends(lb.Spec.Backends),
+		FirewallID:            lb.Spec.FirewallID,
+		FirewallRules:         lb.Spec.FirewallRules,
+		MaxConcurrentRequests: lb.Spec.MaxConcurrentRequests,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created load balancer %s with %d backends", result.Name, len(lb.Spec.Backends))
+
+	return result, nil
+}
+
+// UpdateLoadBalancer updates a load balancer on Civo. The backends are always sent
+// as a whole because Civo replaces the complete list on every update.
+func (c *CivoClient) UpdateLoadBalancer(id string, lb *v1alpha1loadbalancer.CivoLoadBalancer) error {
+	_, err := c.civoGoClient.UpdateLoadBalancer(id, &civogo.LoadBalancerUpdateConfig{
+		Region:                lb.Spec.Region,
+		Name:                  lb.Spec.Name,
+		Algorithm:             lb.Spec.Algorithm,
+		Backends:              ConvertLoadBalancerBackends(lb.Spec.Backends),
+		FirewallID:            lb.Spec.FirewallID,
+		MaxConcurrentRequests: lb.Spec.MaxConcurrentRequests,
+	})
+	return err
+}
+
+// DeleteLoadBalancer deletes a load balancer on Civo.
+func (c *CivoClient) DeleteLoadBalancer(id string) error {
+	lb, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteLoadBalancer(lb.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
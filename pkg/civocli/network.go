@@ -0,0 +1,78 @@
+package civocli
+
+import (
+	"github.com/civo/civogo"
+	v1alpha1network "github.com/crossplane-contrib/provider-civo/apis/civo/network/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateNetworkObservation creates the CivoNetworkObservation from network infos
+func GenerateNetworkObservation(network *civogo.Network) v1alpha1network.CivoNetworkObservation {
+	return v1alpha1network.CivoNetworkObservation{
+		ID:            network.ID,
+		Name:          network.Name,
+		Label:         network.Label,
+		CIDR:          network.CIDR,
+		NameserversV4: network.NameserversV4,
+		Default:       network.Default,
+		Status:        network.Status,
+	}
+}
+
+// GetNetwork gets a network on Civo.
+func (c *CivoClient) GetNetwork(id string) (*civogo.Network, error) {
+	if id == "" {
+		return nil, nil
+	}
+	network, err := c.civoGoClient.GetNetwork(id)
+	if err != nil {
+		if isNotFound(err, civogo.DatabaseNetworkNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return network, nil
+}
+
+// CreateNetwork creates a new private network on Civo.
+func (c *CivoClient) CreateNetwork(network *v1alpha1network.CivoNetwork) (*civogo.NetworkResult, error) {
+	result, err := c.civoGoClient.CreateNetwork(civogo.NetworkConfig{
+		Label:         network.Spec.Label,
+		Region:        network.Spec.Region,
+		CIDRv4:        emptyIfNil(network.Spec.CIDRv4),
+		NameserversV4: network.Spec.NameserversV4,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created network %s", result.Label)
+
+	return result, nil
+}
+
+// UpdateNetwork updates the label and nameservers of a network on Civo.
+func (c *CivoClient) UpdateNetwork(id string, network *v1alpha1network.CivoNetwork) error {
+	_, err := c.civoGoClient.UpdateNetwork(id, civogo.NetworkConfig{
+		Label:         network.Spec.Label,
+		Region:        network.Spec.Region,
+		NameserversV4: network.Spec.NameserversV4,
+	})
+	return err
+}
+
+// DeleteNetwork deletes a network on Civo.
+func (c *CivoClient) DeleteNetwork(id string) error {
+	network, err := c.GetNetwork(id)
+	if err != nil {
+		return err
+	}
+	if network == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteNetwork(network.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
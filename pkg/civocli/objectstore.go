@@ -0,0 +1,174 @@
+package civocli
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/civo/civogo"
+	v1alpha1objectstore "github.com/crossplane-contrib/provider-civo/apis/civo/objectstore/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateObjectStoreObservation creates the CivoObjectStoreObservation from object store infos
+func GenerateObjectStoreObservation(store *civogo.ObjectStore) v1alpha1objectstore.CivoObjectStoreObservation {
+	return v1alpha1objectstore.CivoObjectStoreObservation{
+		ID:           store.ID,
+		Name:         store.Name,
+		MaxSizeGB:    store.MaxSize,
+		Endpoint:     store.BucketURL,
+		AccessKeyID:  store.OwnerInfo.AccessKeyID,
+		CredentialID: store.OwnerInfo.CredentialID,
+		Status:       store.Status,
+	}
+}
+
+// GenerateObjectStoreCredentialObservation creates the CivoObjectStoreCredentialObservation from credential infos
+func GenerateObjectStoreCredentialObservation(credential *civogo.ObjectStoreCredential) v1alpha1objectstore.CivoObjectStoreCredentialObservation {
+	return v1alpha1objectstore.CivoObjectStoreCredentialObservation{
+		ID:          credential.ID,
+		Name:        credential.Name,
+		AccessKeyID: credential.AccessKeyID,
+		MaxSizeGB:   credential.MaxSizeGB,
+		Suspended:   credential.Suspended,
+		Status:      credential.Status,
+	}
+}
+
+// GetObjectStore gets an object store on Civo.
+func (c *CivoClient) GetObjectStore(id string) (*civogo.ObjectStore, error) {
+	if id == "" {
+		return nil, nil
+	}
+	store, err := c.civoGoClient.GetObjectStore(id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return store, nil
+}
+
+// CreateObjectStore creates a new object store on Civo.
+func (c *CivoClient) CreateObjectStore(store *v1alpha1objectstore.CivoObjectStore) (*civogo.ObjectStore, error) {
+	result, err := c.civoGoClient.NewObjectStore(&civogo.CreateObjectStoreRequest{
+		Name:        store.Spec.Name,
+		MaxSizeGB:   store.Spec.MaxSizeGB,
+		AccessKeyID: store.Spec.AccessKeyID,
+		Region:      store.Spec.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created object store %s", result.Name)
+
+	return result, nil
+}
+
+// UpdateObjectStore updates the maximum size of an object store on Civo.
+func (c *CivoClient) UpdateObjectStore(id string, store *v1alpha1objectstore.CivoObjectStore) error {
+	_, err := c.civoGoClient.UpdateObjectStore(id, &civogo.UpdateObjectStoreRequest{
+		MaxSizeGB: store.Spec.MaxSizeGB,
+		Region:    store.Spec.Region,
+	})
+	return err
+}
+
+// DeleteObjectStore deletes an object store on Civo.
+func (c *CivoClient) DeleteObjectStore(id string) error {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return err
+	}
+	if store == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteObjectStore(store.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// GetObjectStoreCredential gets an object store credential on Civo.
+func (c *CivoClient) GetObjectStoreCredential(id string) (*civogo.ObjectStoreCredential, error) {
+	if id == "" {
+		return nil, nil
+	}
+	credential, err := c.civoGoClient.GetObjectStoreCredential(id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return credential, nil
+}
+
+// CreateObjectStoreCredential creates a new object store credential on Civo.
+func (c *CivoClient) CreateObjectStoreCredential(credential *v1alpha1objectstore.CivoObjectStoreCredential) (*civogo.ObjectStoreCredential, error) {
+	result, err := c.civoGoClient.NewObjectStoreCredential(&civogo.CreateObjectStoreCredentialRequest{
+		Name:        credential.Spec.Name,
+		AccessKeyID: credential.Spec.AccessKeyID,
+		MaxSizeGB:   credential.Spec.MaxSizeGB,
+		Region:      credential.Spec.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created object store credential %s", result.Name)
+
+	return result, nil
+}
+
+// UpdateObjectStoreCredential updates the maximum size of an object store credential on Civo.
+// When rotate is set the secret key is replaced with a newly generated one.
+func (c *CivoClient) UpdateObjectStoreCredential(id string, credential *v1alpha1objectstore.CivoObjectStoreCredential, rotate bool) (*civogo.ObjectStoreCredential, error) {
+	req := &civogo.UpdateObjectStoreCredentialRequest{
+		MaxSizeGB: credential.Spec.MaxSizeGB,
+		Region:    credential.Spec.Region,
+	}
+	if rotate {
+		secretKey, err := generateSecretKey()
+		if err != nil {
+			return nil, err
+		}
+		req.SecretAccessKeyID = &secretKey
+	}
+	return c.civoGoClient.UpdateObjectStoreCredential(id, req)
+}
+
+// DeleteObjectStoreCredential deletes an object store credential on Civo.
+func (c *CivoClient) DeleteObjectStoreCredential(id string) error {
+	credential, err := c.GetObjectStoreCredential(id)
+	if err != nil {
+		return err
+	}
+	if credential == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteObjectStoreCredential(credential.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// generateSecretKey returns a random secret key in the format Civo generates itself.
+func generateSecretKey() (string, error) {
+	const (
+		alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+		length   = 40
+	)
+	key := make([]byte, length)
+	for i := range key {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+		if err != nil {
+			return "", err
+		}
+		key[i] = alphabet[n.Int64()]
+	}
+	return string(key), nil
+}
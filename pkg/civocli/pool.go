@@ -0,0 +1,151 @@
+package civocli
+
+import (
+	"github.com/civo/civogo"
+	"github.com/crossplane-contrib/provider-civo/apis/civo/cluster/v1alpha1"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GenerateKubernetesNodePoolObservation creates the CivoKubernetesNodePoolObservation from pool infos
+func GenerateKubernetesNodePoolObservation(clusterID string, pool *civogo.KubernetesPool) v1alpha1.CivoKubernetesNodePoolObservation {
+	return v1alpha1.CivoKubernetesNodePoolObservation{
+		ID:            pool.ID,
+		ClusterID:     clusterID,
+		Size:          pool.Size,
+		Count:         pool.Count,
+		InstanceNames: pool.InstanceNames,
+	}
+}
+
+// GenerateKubernetesPoolObservations creates the KubernetesPoolObservations of
+// the pools of a cluster.
+func GenerateKubernetesPoolObservations(pools []civogo.KubernetesPool) []v1alpha1.KubernetesPoolObservation {
+	observations := make([]v1alpha1.KubernetesPoolObservation, 0, len(pools))
+	for _, pool := range pools {
+		active := 0
+		instances := make([]v1alpha1.KubernetesInstanceObservation, 0, len(pool.Instances))
+		for _, instance := range pool.Instances {
+			if instance.Status == "ACTIVE" {
+				active++
+			}
+			instances = append(instances, v1alpha1.KubernetesInstanceObservation{
+				Name:     instance.Hostname,
+				PublicIP: instance.PublicIP,
+				Status:   instance.Status,
+			})
+		}
+		state := v1alpha1.PoolStateScaling
+		if active == pool.Count && len(pool.Instances) == pool.Count {
+			state = v1alpha1.PoolStateReady
+		}
+		observations = append(observations, v1alpha1.KubernetesPoolObservation{
+			ID:          pool.ID,
+			Size:        pool.Size,
+			Count:       pool.Count,
+			ActiveNodes: active,
+			State:       state,
+			Instances:   instances,
+		})
+	}
+	return observations
+}
+
+// GetKubernetesNodePool gets a pool of a K3s cluster on Civo.
+func (c *CivoClient) GetKubernetesNodePool(clusterID, poolID string) (*civogo.KubernetesPool, error) {
+	if clusterID == "" || poolID == "" {
+		return nil, nil
+	}
+	pool, err := c.civoGoClient.GetKubernetesClusterPool(clusterID, poolID)
+	if err != nil {
+		// The pool is gone with its cluster as well.
+		if isNotFound(err, civogo.DatabaseClusterPoolNotFoundError, civogo.DatabaseKubernetesClusterNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pool, nil
+}
+
+// CreateKubernetesNodePool adds a new pool to a K3s cluster on Civo and returns
+// its ID. Civo does not return the ID of a new pool, so it is generated here.
+func (c *CivoClient) CreateKubernetesNodePool(clusterID string, spec v1alpha1.CivoKubernetesNodePoolSpec) (string, error) {
+	id := uuid.NewString()
+	err := c.createPool(clusterID, &civogo.KubernetesClusterPoolUpdateConfig{
+		ID:               id,
+		Count:            spec.Count,
+		Size:             spec.Size,
+		Labels:           spec.Labels,
+		Taints:           spec.Taints,
+		PublicIPNodePool: spec.PublicIPNodePool,
+	})
+	return id, err
+}
+
+// CreateK3sClusterPool adds a pool of the cluster spec to a K3s cluster on Civo.
+func (c *CivoClient) CreateK3sClusterPool(clusterID string, pool v1alpha1.KubernetesClusterPoolConfig) error {
+	return c.createPool(clusterID, &civogo.KubernetesClusterPoolUpdateConfig{
+		ID:               pool.ID,
+		Count:            pool.Count,
+		Size:             pool.Size,
+		Labels:           pool.Labels,
+		Taints:           pool.Taints,
+		PublicIPNodePool: pool.PublicIPNodePool,
+	})
+}
+
+func (c *CivoClient) createPool(clusterID string, cfg *civogo.KubernetesClusterPoolUpdateConfig) error {
+	resp, err := c.civoGoClient.CreateKubernetesClusterPool(clusterID, cfg)
+	if err != nil {
+		if resp != nil {
+			log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+		}
+		return err
+	}
+
+	log.Debugf("Created pool %s in Kubernetes cluster %s", cfg.ID, clusterID)
+
+	return nil
+}
+
+// UpdateKubernetesNodePool updates the node count, labels and taints of a pool
+// of a K3s cluster on Civo.
+func (c *CivoClient) UpdateKubernetesNodePool(clusterID, poolID string, spec v1alpha1.CivoKubernetesNodePoolSpec) error {
+	return c.updatePool(clusterID, poolID, spec.Count, spec.Labels, spec.Taints)
+}
+
+// UpdateK3sClusterPool updates the node count, labels and taints of a pool of
+// the cluster spec on Civo.
+func (c *CivoClient) UpdateK3sClusterPool(clusterID string, pool v1alpha1.KubernetesClusterPoolConfig) error {
+	return c.updatePool(clusterID, pool.ID, pool.Count, pool.Labels, pool.Taints)
+}
+
+func (c *CivoClient) updatePool(clusterID, poolID string, count int, labels map[string]string, taints []corev1.Taint) error {
+	// Taints are always sent so that removing them from the spec clears them.
+	if taints == nil {
+		taints = []corev1.Taint{}
+	}
+	_, err := c.civoGoClient.UpdateKubernetesClusterPool(clusterID, poolID, &civogo.KubernetesClusterPoolUpdateConfig{
+		Count:  count,
+		Labels: labels,
+		Taints: taints,
+	})
+	return err
+}
+
+// DeleteKubernetesNodePool deletes a pool of a K3s cluster on Civo.
+func (c *CivoClient) DeleteKubernetesNodePool(clusterID, poolID string) error {
+	pool, err := c.GetKubernetesNodePool(clusterID, poolID)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteKubernetesClusterPool(clusterID, poolID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
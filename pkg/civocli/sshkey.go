@@ -0,0 +1,97 @@
+package civocli
+
+import (
+	"crypto/md5" //nolint:gosec // MD5 is the legacy SSH fingerprint format.
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	v1alpha1sshkey "github.com/crossplane-contrib/provider-civo/apis/civo/sshkey/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+// GenerateSSHKeyObservation creates the CivoSSHKeyObservation from SSH key infos
+func GenerateSSHKeyObservation(sshKey *civogo.SSHKey) v1alpha1sshkey.CivoSSHKeyObservation {
+	return v1alpha1sshkey.CivoSSHKeyObservation{
+		ID:          sshKey.ID,
+		Name:        sshKey.Name,
+		Fingerprint: sshKey.Fingerprint,
+	}
+}
+
+// GetSSHKey gets an SSH key on Civo.
+func (c *CivoClient) GetSSHKey(id string) (*civogo.SSHKey, error) {
+	if id == "" {
+		return nil, nil
+	}
+	sshKeys, err := c.civoGoClient.ListSSHKeys()
+	if err != nil {
+		return nil, err
+	}
+	for i := range sshKeys {
+		if sshKeys[i].ID == id {
+			return &sshKeys[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateSSHKey uploads a new SSH public key to Civo and returns its ID.
+func (c *CivoClient) CreateSSHKey(name, publicKey string) (string, error) {
+	resp, err := c.civoGoClient.NewSSHKey(name, publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	log.Debugf("Created SSH key %s", name)
+
+	return resp.ID, nil
+}
+
+// RenameSSHKey renames an SSH key on Civo.
+func (c *CivoClient) RenameSSHKey(id, name string) error {
+	_, err := c.civoGoClient.UpdateSSHKey(name, id)
+	return err
+}
+
+// DeleteSSHKey deletes an SSH key on Civo.
+func (c *CivoClient) DeleteSSHKey(id string) error {
+	sshKey, err := c.GetSSHKey(id)
+	if err != nil {
+		return err
+	}
+	if sshKey == nil {
+		return nil
+	}
+	resp, err := c.civoGoClient.DeleteSSHKey(sshKey.ID)
+	if err != nil && resp != nil {
+		log.Debugf("error [%s %s %s %s]", resp.Result, resp.ErrorDetails, resp.ErrorCode, resp.ErrorReason)
+	}
+	return err
+}
+
+// SSHKeyMatchesFingerprint reports whether an SSH public key has the given
+// fingerprint, either in the SHA256 or in the legacy MD5 format. A key that
+// cannot be parsed never matches.
+func SSHKeyMatchesFingerprint(publicKey, fingerprint string) bool {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return false
+	}
+	key, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return false
+	}
+	sha := sha256.Sum256(key)
+	if fingerprint == "SHA256:"+base64.RawStdEncoding.EncodeToString(sha[:]) {
+		return true
+	}
+	sum := md5.Sum(key) //nolint:gosec // MD5 is the legacy SSH fingerprint format.
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.TrimPrefix(fingerprint, "MD5:") == strings.Join(hex, ":")
+}
@@ -0,0 +1,53 @@
+package civocli
+
+import "testing"
+
+func TestSSHKeyMatchesFingerprint(t *testing.T) {
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDjG8Q1P8r4/fqSCjB/djHT++8dWkqN27buW3vqoD6+I test"
+
+	cases := map[string]struct {
+		reason      string
+		publicKey   string
+		fingerprint string
+		want        bool
+	}{
+		"SHA256": {
+			reason:      "The fingerprint printed by ssh-keygen -l matches.",
+			publicKey:   publicKey,
+			fingerprint: "SHA256:h3uO2GqyuTTA1IOb/43qRCe0Il34vCOwHDM9+KTBV+8",
+			want:        true,
+		},
+		"LegacyMD5": {
+			reason:      "The colon separated MD5 fingerprint matches with or without its prefix.",
+			publicKey:   publicKey,
+			fingerprint: "ee:b9:a0:4b:3f:ea:86:2f:25:5d:6c:bd:15:62:3c:c4",
+			want:        true,
+		},
+		"TrailingNewline": {
+			reason:      "A key read from a Secret usually ends with a newline and has no comment.",
+			publicKey:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDjG8Q1P8r4/fqSCjB/djHT++8dWkqN27buW3vqoD6+I\n",
+			fingerprint: "MD5:ee:b9:a0:4b:3f:ea:86:2f:25:5d:6c:bd:15:62:3c:c4",
+			want:        true,
+		},
+		"RotatedKey": {
+			reason:      "A different key does not match the fingerprint of the uploaded one.",
+			publicKey:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIH7x0bXxJ2Q0s3kq6Q8rU3mH0eY4bKf1m6Zr9dV2cN1a",
+			fingerprint: "SHA256:h3uO2GqyuTTA1IOb/43qRCe0Il34vCOwHDM9+KTBV+8",
+			want:        false,
+		},
+		"NotAKey": {
+			reason:      "Garbage in the Secret never matches.",
+			publicKey:   "not-a-key",
+			fingerprint: "SHA256:h3uO2GqyuTTA1IOb/43qRCe0Il34vCOwHDM9+KTBV+8",
+			want:        false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SSHKeyMatchesFingerprint(tc.publicKey, tc.fingerprint); got != tc.want {
+				t.Errorf("\n%s\nSSHKeyMatchesFingerprint(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
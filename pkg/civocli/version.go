@@ -0,0 +1,79 @@
+package civocli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// KubernetesVersion is a parsed Civo Kubernetes version such as 1.22.2-k3s1.
+type KubernetesVersion struct {
+	Major    int
+	Minor    int
+	Patch    int
+	Revision int
+}
+
+// ParseKubernetesVersion parses a Civo Kubernetes version. A leading v is
+// ignored, and the revision is taken from a -k3sN or +k3sN suffix.
+func ParseKubernetesVersion(version string) (KubernetesVersion, error) {
+	v := KubernetesVersion{}
+	core, suffix := strings.TrimPrefix(version, "v"), ""
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core, suffix = core[:i], core[i+1:]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v, errors.Errorf("invalid Kubernetes version %q", version)
+	}
+	numbers := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return v, errors.Errorf("invalid Kubernetes version %q", version)
+		}
+		numbers[i] = n
+	}
+	v.Major, v.Minor, v.Patch = numbers[0], numbers[1], numbers[2]
+
+	if strings.HasPrefix(suffix, "k3s") {
+		n, err := strconv.Atoi(strings.TrimPrefix(suffix, "k3s"))
+		if err != nil {
+			return v, errors.Errorf("invalid Kubernetes version %q", version)
+		}
+		v.Revision = n
+	}
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 if v is older than, the same as or newer than o.
+func (v KubernetesVersion) Compare(o KubernetesVersion) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch, v.Revision - o.Revision} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// ListK3sVersions lists the K3s versions Civo offers for new installs and
+// upgrades. Deprecated versions are left out.
+func (c *CivoClient) ListK3sVersions() ([]string, error) {
+	versions, err := c.civoGoClient.ListAvailableKubernetesVersions()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if v.Type == "deprecated" || (v.ClusterType != "" && v.ClusterType != "k3s") {
+			continue
+		}
+		out = append(out, v.Version)
+	}
+	return out, nil
+}
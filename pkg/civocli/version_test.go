@@ -0,0 +1,107 @@
+package civocli
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseKubernetesVersion(t *testing.T) {
+	type want struct {
+		version KubernetesVersion
+		err     bool
+	}
+
+	cases := map[string]struct {
+		version string
+		want    want
+	}{
+		"K3sSuffix": {
+			version: "1.22.2-k3s1",
+			want:    want{version: KubernetesVersion{Major: 1, Minor: 22, Patch: 2, Revision: 1}},
+		},
+		"K3sBuildMetadata": {
+			version: "v1.28.7+k3s1",
+			want:    want{version: KubernetesVersion{Major: 1, Minor: 28, Patch: 7, Revision: 1}},
+		},
+		"NoSuffix": {
+			version: "1.29.0",
+			want:    want{version: KubernetesVersion{Major: 1, Minor: 29}},
+		},
+		"NoPatch": {
+			version: "1.9",
+			want:    want{version: KubernetesVersion{Major: 1, Minor: 9}},
+		},
+		"OtherSuffix": {
+			version: "1.27.1-rc1",
+			want:    want{version: KubernetesVersion{Major: 1, Minor: 27, Patch: 1}},
+		},
+		"Empty": {
+			version: "",
+			want:    want{err: true},
+		},
+		"NotANumber": {
+			version: "1.x.2",
+			want:    want{err: true},
+		},
+		"TooManyParts": {
+			version: "1.22.2.1",
+			want:    want{err: true},
+		},
+		"InvalidK3sRevision": {
+			version: "1.22.2-k3sX",
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseKubernetesVersion(tc.version)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("ParseKubernetesVersion(%q): want error %t, got %v", tc.version, tc.want.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.version, got); diff != "" {
+				t.Errorf("ParseKubernetesVersion(%q): -want, +got:\n%s", tc.version, diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesVersionCompare(t *testing.T) {
+	cases := map[string]struct {
+		v    string
+		o    string
+		want int
+	}{
+		"Equal":                {v: "1.22.2-k3s1", o: "1.22.2-k3s1", want: 0},
+		"LeadingVIgnored":      {v: "v1.22.2-k3s1", o: "1.22.2-k3s1", want: 0},
+		"NewerMinorNotString":  {v: "1.22.0", o: "1.9.0", want: 1},
+		"OlderMinorNotString":  {v: "1.9.0", o: "1.22.0", want: -1},
+		"NewerMajor":           {v: "2.0.0", o: "1.29.9", want: 1},
+		"NewerPatch":           {v: "1.22.10", o: "1.22.9", want: 1},
+		"NewerRevision":        {v: "1.22.2-k3s2", o: "1.22.2-k3s1", want: 1},
+		"OlderRevision":        {v: "1.22.2-k3s1", o: "1.22.2-k3s2", want: -1},
+		"MissingPatchIsZero":   {v: "1.22", o: "1.22.0", want: 0},
+		"PatchBeforeRevision":  {v: "1.22.3-k3s1", o: "1.22.2-k3s9", want: 1},
+		"MissingSuffixIsOlder": {v: "1.22.2", o: "1.22.2-k3s1", want: -1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v, err := ParseKubernetesVersion(tc.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			o, err := ParseKubernetesVersion(tc.o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := v.Compare(o); got != tc.want {
+				t.Errorf("%s.Compare(%s): want %d, got %d", tc.v, tc.o, tc.want, got)
+			}
+		})
+	}
+}